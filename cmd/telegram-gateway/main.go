@@ -10,6 +10,7 @@ import (
 
 	feedv1 "ghostnet/gen/go/proto/feed/v1"
 	interactionv1 "ghostnet/gen/go/proto/interaction/v1"
+	moderationv1 "ghostnet/gen/go/proto/moderation/v1"
 	postv1 "ghostnet/gen/go/proto/post/v1"
 	userv1 "ghostnet/gen/go/proto/user/v1"
 	"ghostnet/internal/common/config"
@@ -82,9 +83,14 @@ func main() {
 		postv1.NewPostServiceClient(postConn),
 		interactionv1.NewInteractionServiceClient(interactionConn),
 		feedv1.NewFeedServiceClient(feedConn),
+		moderationv1.NewModerationServiceClient(interactionConn),
+		cfg.TelegramBotToken,
 		logg,
 	)
 
+	go handler.RunComposerSweeper(ctx)
+	go handler.RunEngagementSweeper(ctx)
+
 	if cfg.TelegramBotToken == "" || cfg.TelegramBotToken == "SET_ME" {
 		logg.Warn("TELEGRAM_BOT_TOKEN is not set; polling disabled")
 	} else {
@@ -23,31 +23,70 @@ const (
 type EventType int32
 
 const (
-	EventType_EVENT_TYPE_UNSPECIFIED   EventType = 0
-	EventType_EVENT_TYPE_POST_CREATED  EventType = 1
-	EventType_EVENT_TYPE_POST_LIKED    EventType = 2
-	EventType_EVENT_TYPE_POST_DISLIKED EventType = 3
-	EventType_EVENT_TYPE_COMMENT_ADDED EventType = 4
-	EventType_EVENT_TYPE_POST_VIEWED   EventType = 5
+	EventType_EVENT_TYPE_UNSPECIFIED            EventType = 0
+	EventType_EVENT_TYPE_POST_CREATED           EventType = 1
+	EventType_EVENT_TYPE_POST_LIKED             EventType = 2
+	EventType_EVENT_TYPE_POST_DISLIKED          EventType = 3
+	EventType_EVENT_TYPE_COMMENT_ADDED          EventType = 4
+	EventType_EVENT_TYPE_POST_VIEWED            EventType = 5
+	EventType_EVENT_TYPE_POLL_VOTED             EventType = 6
+	EventType_EVENT_TYPE_POST_REPOSTED          EventType = 7
+	EventType_EVENT_TYPE_VOTE_REMOVED           EventType = 8
+	EventType_EVENT_TYPE_VOTE_CHANGED           EventType = 9
+	EventType_EVENT_TYPE_COMMENT_DELETED        EventType = 10
+	EventType_EVENT_TYPE_COMMENT_EDITED         EventType = 11
+	EventType_EVENT_TYPE_COMMENT_REPLIED        EventType = 12
+	EventType_EVENT_TYPE_POST_REACTED           EventType = 13
+	EventType_EVENT_TYPE_COMMENT_LIKE_MILESTONE EventType = 14
+	EventType_EVENT_TYPE_POST_REPORTED          EventType = 15 // на пост пожаловались; жалобщик не раскрывается
+	EventType_EVENT_TYPE_POST_AUTO_HIDDEN       EventType = 16 // пост скрыт автоматически и ждёт проверки
+	EventType_EVENT_TYPE_POST_RESTORED          EventType = 17 // модератор вернул автоматически скрытый пост
+	EventType_EVENT_TYPE_POST_ENGAGED           EventType = 18 // сигнал вовлечённости для ранжирования ленты
 )
 
 // Enum value maps for EventType.
 var (
 	EventType_name = map[int32]string{
-		0: "EVENT_TYPE_UNSPECIFIED",
-		1: "EVENT_TYPE_POST_CREATED",
-		2: "EVENT_TYPE_POST_LIKED",
-		3: "EVENT_TYPE_POST_DISLIKED",
-		4: "EVENT_TYPE_COMMENT_ADDED",
-		5: "EVENT_TYPE_POST_VIEWED",
+		0:  "EVENT_TYPE_UNSPECIFIED",
+		1:  "EVENT_TYPE_POST_CREATED",
+		2:  "EVENT_TYPE_POST_LIKED",
+		3:  "EVENT_TYPE_POST_DISLIKED",
+		4:  "EVENT_TYPE_COMMENT_ADDED",
+		5:  "EVENT_TYPE_POST_VIEWED",
+		6:  "EVENT_TYPE_POLL_VOTED",
+		7:  "EVENT_TYPE_POST_REPOSTED",
+		8:  "EVENT_TYPE_VOTE_REMOVED",
+		9:  "EVENT_TYPE_VOTE_CHANGED",
+		10: "EVENT_TYPE_COMMENT_DELETED",
+		11: "EVENT_TYPE_COMMENT_EDITED",
+		12: "EVENT_TYPE_COMMENT_REPLIED",
+		13: "EVENT_TYPE_POST_REACTED",
+		14: "EVENT_TYPE_COMMENT_LIKE_MILESTONE",
+		15: "EVENT_TYPE_POST_REPORTED",
+		16: "EVENT_TYPE_POST_AUTO_HIDDEN",
+		17: "EVENT_TYPE_POST_RESTORED",
+		18: "EVENT_TYPE_POST_ENGAGED",
 	}
 	EventType_value = map[string]int32{
-		"EVENT_TYPE_UNSPECIFIED":   0,
-		"EVENT_TYPE_POST_CREATED":  1,
-		"EVENT_TYPE_POST_LIKED":    2,
-		"EVENT_TYPE_POST_DISLIKED": 3,
-		"EVENT_TYPE_COMMENT_ADDED": 4,
-		"EVENT_TYPE_POST_VIEWED":   5,
+		"EVENT_TYPE_UNSPECIFIED":            0,
+		"EVENT_TYPE_POST_CREATED":           1,
+		"EVENT_TYPE_POST_LIKED":             2,
+		"EVENT_TYPE_POST_DISLIKED":          3,
+		"EVENT_TYPE_COMMENT_ADDED":          4,
+		"EVENT_TYPE_POST_VIEWED":            5,
+		"EVENT_TYPE_POLL_VOTED":             6,
+		"EVENT_TYPE_POST_REPOSTED":          7,
+		"EVENT_TYPE_VOTE_REMOVED":           8,
+		"EVENT_TYPE_VOTE_CHANGED":           9,
+		"EVENT_TYPE_COMMENT_DELETED":        10,
+		"EVENT_TYPE_COMMENT_EDITED":         11,
+		"EVENT_TYPE_COMMENT_REPLIED":        12,
+		"EVENT_TYPE_POST_REACTED":           13,
+		"EVENT_TYPE_COMMENT_LIKE_MILESTONE": 14,
+		"EVENT_TYPE_POST_REPORTED":          15,
+		"EVENT_TYPE_POST_AUTO_HIDDEN":       16,
+		"EVENT_TYPE_POST_RESTORED":          17,
+		"EVENT_TYPE_POST_ENGAGED":           18,
 	}
 )
 
@@ -78,6 +117,64 @@ func (EventType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_events_v1_events_proto_rawDescGZIP(), []int{0}
 }
 
+type ModerationEventType int32
+
+const (
+	ModerationEventType_MODERATION_EVENT_TYPE_UNSPECIFIED         ModerationEventType = 0
+	ModerationEventType_MODERATION_EVENT_TYPE_REPORT_DISMISSED    ModerationEventType = 1
+	ModerationEventType_MODERATION_EVENT_TYPE_CONTENT_HIDDEN      ModerationEventType = 2
+	ModerationEventType_MODERATION_EVENT_TYPE_USER_BANNED         ModerationEventType = 3
+	ModerationEventType_MODERATION_EVENT_TYPE_CONTENT_AUTO_HIDDEN ModerationEventType = 4 // moderator_user_id пуст
+	ModerationEventType_MODERATION_EVENT_TYPE_CONTENT_RESTORED    ModerationEventType = 5
+)
+
+// Enum value maps for ModerationEventType.
+var (
+	ModerationEventType_name = map[int32]string{
+		0: "MODERATION_EVENT_TYPE_UNSPECIFIED",
+		1: "MODERATION_EVENT_TYPE_REPORT_DISMISSED",
+		2: "MODERATION_EVENT_TYPE_CONTENT_HIDDEN",
+		3: "MODERATION_EVENT_TYPE_USER_BANNED",
+		4: "MODERATION_EVENT_TYPE_CONTENT_AUTO_HIDDEN",
+		5: "MODERATION_EVENT_TYPE_CONTENT_RESTORED",
+	}
+	ModerationEventType_value = map[string]int32{
+		"MODERATION_EVENT_TYPE_UNSPECIFIED":         0,
+		"MODERATION_EVENT_TYPE_REPORT_DISMISSED":    1,
+		"MODERATION_EVENT_TYPE_CONTENT_HIDDEN":      2,
+		"MODERATION_EVENT_TYPE_USER_BANNED":         3,
+		"MODERATION_EVENT_TYPE_CONTENT_AUTO_HIDDEN": 4,
+		"MODERATION_EVENT_TYPE_CONTENT_RESTORED":    5,
+	}
+)
+
+func (x ModerationEventType) Enum() *ModerationEventType {
+	p := new(ModerationEventType)
+	*p = x
+	return p
+}
+
+func (x ModerationEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_events_v1_events_proto_enumTypes[1].Descriptor()
+}
+
+func (ModerationEventType) Type() protoreflect.EnumType {
+	return &file_proto_events_v1_events_proto_enumTypes[1]
+}
+
+func (x ModerationEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationEventType.Descriptor instead.
+func (ModerationEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
 type PostEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -90,6 +187,20 @@ type PostEvent struct {
 	PostAuthorId int64     `protobuf:"varint,5,opt,name=post_author_id,json=postAuthorId,proto3" json:"post_author_id,omitempty"`
 	CommentId    int64     `protobuf:"varint,6,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
 	CreatedAt    string    `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RepostId     int64     `protobuf:"varint,8,opt,name=repost_id,json=repostId,proto3" json:"repost_id,omitempty"` // для POST_REPOSTED: id нового поста-репоста
+	// для VOTE_REMOVED/VOTE_CHANGED: 1 — лайк, -1 — дизлайк, 0 — нет голоса
+	PreviousVote int32 `protobuf:"varint,9,opt,name=previous_vote,json=previousVote,proto3" json:"previous_vote,omitempty"`
+	Vote         int32 `protobuf:"varint,10,opt,name=vote,proto3" json:"vote,omitempty"`
+	// для COMMENT_ADDED/COMMENT_REPLIED с ответом: комментарий, на который ответили, и его автор
+	ParentCommentId int64  `protobuf:"varint,11,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
+	RecipientUserId int64  `protobuf:"varint,12,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	Reaction        string `protobuf:"bytes,13,opt,name=reaction,proto3" json:"reaction,omitempty"` // для POST_REACTED: эмодзи реакции
+	// для COMMENT_LIKE_MILESTONE: достигнутое число лайков; получатель — автор комментария
+	Milestone int64 `protobuf:"varint,14,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	// для POST_ENGAGED: сколько пост был на экране, пролистан ли сразу и открывались ли комментарии
+	DwellMs        int64 `protobuf:"varint,15,opt,name=dwell_ms,json=dwellMs,proto3" json:"dwell_ms,omitempty"`
+	Skipped        bool  `protobuf:"varint,16,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	CommentsOpened bool  `protobuf:"varint,17,opt,name=comments_opened,json=commentsOpened,proto3" json:"comments_opened,omitempty"`
 }
 
 func (x *PostEvent) Reset() {
@@ -173,12 +284,203 @@ func (x *PostEvent) GetCreatedAt() string {
 	return ""
 }
 
+func (x *PostEvent) GetRepostId() int64 {
+	if x != nil {
+		return x.RepostId
+	}
+	return 0
+}
+
+func (x *PostEvent) GetPreviousVote() int32 {
+	if x != nil {
+		return x.PreviousVote
+	}
+	return 0
+}
+
+func (x *PostEvent) GetVote() int32 {
+	if x != nil {
+		return x.Vote
+	}
+	return 0
+}
+
+func (x *PostEvent) GetParentCommentId() int64 {
+	if x != nil {
+		return x.ParentCommentId
+	}
+	return 0
+}
+
+func (x *PostEvent) GetRecipientUserId() int64 {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return 0
+}
+
+func (x *PostEvent) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *PostEvent) GetMilestone() int64 {
+	if x != nil {
+		return x.Milestone
+	}
+	return 0
+}
+
+func (x *PostEvent) GetDwellMs() int64 {
+	if x != nil {
+		return x.DwellMs
+	}
+	return 0
+}
+
+func (x *PostEvent) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *PostEvent) GetCommentsOpened() bool {
+	if x != nil {
+		return x.CommentsOpened
+	}
+	return false
+}
+
+// ModerationEvent публикуется в топик moderation-events при каждом решении модератора
+// и при автоматическом скрытии.
+type ModerationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId         string              `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType       ModerationEventType `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=events.v1.ModerationEventType" json:"event_type,omitempty"`
+	TargetType      string              `protobuf:"bytes,3,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"` // "post" или "comment"
+	TargetId        int64               `protobuf:"varint,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	PostId          int64               `protobuf:"varint,5,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	AuthorUserId    int64               `protobuf:"varint,6,opt,name=author_user_id,json=authorUserId,proto3" json:"author_user_id,omitempty"`
+	ModeratorUserId int64               `protobuf:"varint,7,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ReportIds       []int64             `protobuf:"varint,8,rep,packed,name=report_ids,json=reportIds,proto3" json:"report_ids,omitempty"`
+	Note            string              `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt       string              `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ModerationEvent) Reset() {
+	*x = ModerationEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_events_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationEvent) ProtoMessage() {}
+
+func (x *ModerationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_events_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationEvent.ProtoReflect.Descriptor instead.
+func (*ModerationEvent) Descriptor() ([]byte, []int) {
+	return file_proto_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ModerationEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ModerationEvent) GetEventType() ModerationEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return ModerationEventType_MODERATION_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ModerationEvent) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *ModerationEvent) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *ModerationEvent) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ModerationEvent) GetAuthorUserId() int64 {
+	if x != nil {
+		return x.AuthorUserId
+	}
+	return 0
+}
+
+func (x *ModerationEvent) GetModeratorUserId() int64 {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return 0
+}
+
+func (x *ModerationEvent) GetReportIds() []int64 {
+	if x != nil {
+		return x.ReportIds
+	}
+	return nil
+}
+
+func (x *ModerationEvent) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ModerationEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
 var File_proto_events_v1_events_proto protoreflect.FileDescriptor
 
 var file_proto_events_v1_events_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76,
 	0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x22, 0xfc, 0x01, 0x0a, 0x09, 0x50, 0x6f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x22, 0xc2, 0x04, 0x0a, 0x09, 0x50, 0x6f,
 	0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
 	0x49, 0x64, 0x12, 0x33, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
@@ -194,22 +496,107 @@ var file_proto_events_v1_events_proto_rawDesc = []byte{
 	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
 	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0xb7, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
-	0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f,
-	0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x44, 0x49,
-	0x53, 0x4c, 0x49, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41,
-	0x44, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x45, 0x44,
-	0x10, 0x05, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x65, 0x74, 0x2f, 0x67,
-	0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f,
-	0x76, 0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6f,
+	0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x2a,
+	0x0a, 0x11, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x22, 0xe6,
+	0x02, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0xc7, 0x04, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x50, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19,
+	0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53,
+	0x54, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x44, 0x49, 0x53,
+	0x4c, 0x49, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x44,
+	0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x45, 0x44, 0x10,
+	0x05, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x50, 0x4f, 0x4c, 0x4c, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f,
+	0x52, 0x45, 0x50, 0x4f, 0x53, 0x54, 0x45, 0x44, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x52, 0x45,
+	0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x08, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x44, 0x10, 0x09, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x10, 0x0a, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x44, 0x49, 0x54, 0x45,
+	0x44, 0x10, 0x0b, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x45,
+	0x44, 0x10, 0x0c, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x54, 0x45, 0x44, 0x10, 0x0d,
+	0x12, 0x25, 0x0a, 0x21, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43,
+	0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x5f, 0x4d, 0x49, 0x4c, 0x45,
+	0x53, 0x54, 0x4f, 0x4e, 0x45, 0x10, 0x0e, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52,
+	0x54, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x48, 0x49,
+	0x44, 0x44, 0x45, 0x4e, 0x10, 0x10, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52,
+	0x45, 0x44, 0x10, 0x11, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x45, 0x4e, 0x47, 0x41, 0x47, 0x45, 0x44, 0x10,
+	0x12, 0x2a, 0x94, 0x02, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x4d, 0x4f, 0x44,
+	0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x2a, 0x0a, 0x26, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x44, 0x49, 0x53, 0x4d, 0x49, 0x53, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24,
+	0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x49,
+	0x44, 0x44, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x42, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x2d, 0x0a,
+	0x29, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x41,
+	0x55, 0x54, 0x4f, 0x5f, 0x48, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x04, 0x12, 0x2a, 0x0a, 0x26,
+	0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x05, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x65, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -224,19 +611,22 @@ func file_proto_events_v1_events_proto_rawDescGZIP() []byte {
 	return file_proto_events_v1_events_proto_rawDescData
 }
 
-var file_proto_events_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_events_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_proto_events_v1_events_proto_goTypes = []interface{}{
-	(EventType)(0),    // 0: events.v1.EventType
-	(*PostEvent)(nil), // 1: events.v1.PostEvent
+	(EventType)(0),           // 0: events.v1.EventType
+	(ModerationEventType)(0), // 1: events.v1.ModerationEventType
+	(*PostEvent)(nil),        // 2: events.v1.PostEvent
+	(*ModerationEvent)(nil),  // 3: events.v1.ModerationEvent
 }
 var file_proto_events_v1_events_proto_depIdxs = []int32{
 	0, // 0: events.v1.PostEvent.event_type:type_name -> events.v1.EventType
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: events.v1.ModerationEvent.event_type:type_name -> events.v1.ModerationEventType
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_proto_events_v1_events_proto_init() }
@@ -257,14 +647,26 @@ func file_proto_events_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_proto_events_v1_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerationEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_events_v1_events_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
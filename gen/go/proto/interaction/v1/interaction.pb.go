@@ -20,6 +20,155 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type VoteState int32
+
+const (
+	VoteState_VOTE_STATE_NONE    VoteState = 0
+	VoteState_VOTE_STATE_LIKE    VoteState = 1
+	VoteState_VOTE_STATE_DISLIKE VoteState = 2
+)
+
+// Enum value maps for VoteState.
+var (
+	VoteState_name = map[int32]string{
+		0: "VOTE_STATE_NONE",
+		1: "VOTE_STATE_LIKE",
+		2: "VOTE_STATE_DISLIKE",
+	}
+	VoteState_value = map[string]int32{
+		"VOTE_STATE_NONE":    0,
+		"VOTE_STATE_LIKE":    1,
+		"VOTE_STATE_DISLIKE": 2,
+	}
+)
+
+func (x VoteState) Enum() *VoteState {
+	p := new(VoteState)
+	*p = x
+	return p
+}
+
+func (x VoteState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VoteState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_interaction_v1_interaction_proto_enumTypes[0].Descriptor()
+}
+
+func (VoteState) Type() protoreflect.EnumType {
+	return &file_proto_interaction_v1_interaction_proto_enumTypes[0]
+}
+
+func (x VoteState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VoteState.Descriptor instead.
+func (VoteState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{0}
+}
+
+type CommentLayout int32
+
+const (
+	// все комментарии и ответы одним списком от новых к старым
+	CommentLayout_COMMENT_LAYOUT_FLAT CommentLayout = 0
+	// страница корневых комментариев, ответы вложены в replies от старых к новым
+	CommentLayout_COMMENT_LAYOUT_TREE CommentLayout = 1
+)
+
+// Enum value maps for CommentLayout.
+var (
+	CommentLayout_name = map[int32]string{
+		0: "COMMENT_LAYOUT_FLAT",
+		1: "COMMENT_LAYOUT_TREE",
+	}
+	CommentLayout_value = map[string]int32{
+		"COMMENT_LAYOUT_FLAT": 0,
+		"COMMENT_LAYOUT_TREE": 1,
+	}
+)
+
+func (x CommentLayout) Enum() *CommentLayout {
+	p := new(CommentLayout)
+	*p = x
+	return p
+}
+
+func (x CommentLayout) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentLayout) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_interaction_v1_interaction_proto_enumTypes[1].Descriptor()
+}
+
+func (CommentLayout) Type() protoreflect.EnumType {
+	return &file_proto_interaction_v1_interaction_proto_enumTypes[1]
+}
+
+func (x CommentLayout) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentLayout.Descriptor instead.
+func (CommentLayout) EnumDescriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{1}
+}
+
+type CommentSort int32
+
+const (
+	CommentSort_COMMENT_SORT_NEW           CommentSort = 0
+	CommentSort_COMMENT_SORT_OLD           CommentSort = 1
+	CommentSort_COMMENT_SORT_TOP           CommentSort = 2 // по likes - dislikes
+	CommentSort_COMMENT_SORT_CONTROVERSIAL CommentSort = 3 // много голосов с близким числом лайков и дизлайков
+)
+
+// Enum value maps for CommentSort.
+var (
+	CommentSort_name = map[int32]string{
+		0: "COMMENT_SORT_NEW",
+		1: "COMMENT_SORT_OLD",
+		2: "COMMENT_SORT_TOP",
+		3: "COMMENT_SORT_CONTROVERSIAL",
+	}
+	CommentSort_value = map[string]int32{
+		"COMMENT_SORT_NEW":           0,
+		"COMMENT_SORT_OLD":           1,
+		"COMMENT_SORT_TOP":           2,
+		"COMMENT_SORT_CONTROVERSIAL": 3,
+	}
+)
+
+func (x CommentSort) Enum() *CommentSort {
+	p := new(CommentSort)
+	*p = x
+	return p
+}
+
+func (x CommentSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_interaction_v1_interaction_proto_enumTypes[2].Descriptor()
+}
+
+func (CommentSort) Type() protoreflect.EnumType {
+	return &file_proto_interaction_v1_interaction_proto_enumTypes[2]
+}
+
+func (x CommentSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentSort.Descriptor instead.
+func (CommentSort) EnumDescriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{2}
+}
+
 type LikePostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -27,6 +176,9 @@ type LikePostRequest struct {
 
 	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// повтор с тем же ключом и теми же данными возвращает исходный ответ,
+	// с другими данными — ALREADY_EXISTS; ключи живут IDEMPOTENCY_TTL
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *LikePostRequest) Reset() {
@@ -75,10 +227,20 @@ func (x *LikePostRequest) GetPostId() int64 {
 	return 0
 }
 
+func (x *LikePostRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type LikePostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PreviousVote VoteState `protobuf:"varint,1,opt,name=previous_vote,json=previousVote,proto3,enum=interaction.v1.VoteState" json:"previous_vote,omitempty"`
+	CurrentVote  VoteState `protobuf:"varint,2,opt,name=current_vote,json=currentVote,proto3,enum=interaction.v1.VoteState" json:"current_vote,omitempty"`
 }
 
 func (x *LikePostResponse) Reset() {
@@ -113,13 +275,28 @@ func (*LikePostResponse) Descriptor() ([]byte, []int) {
 	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *LikePostResponse) GetPreviousVote() VoteState {
+	if x != nil {
+		return x.PreviousVote
+	}
+	return VoteState_VOTE_STATE_NONE
+}
+
+func (x *LikePostResponse) GetCurrentVote() VoteState {
+	if x != nil {
+		return x.CurrentVote
+	}
+	return VoteState_VOTE_STATE_NONE
+}
+
 type DislikePostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId         int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *DislikePostRequest) Reset() {
@@ -168,10 +345,20 @@ func (x *DislikePostRequest) GetPostId() int64 {
 	return 0
 }
 
+func (x *DislikePostRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type DislikePostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PreviousVote VoteState `protobuf:"varint,1,opt,name=previous_vote,json=previousVote,proto3,enum=interaction.v1.VoteState" json:"previous_vote,omitempty"`
+	CurrentVote  VoteState `protobuf:"varint,2,opt,name=current_vote,json=currentVote,proto3,enum=interaction.v1.VoteState" json:"current_vote,omitempty"`
 }
 
 func (x *DislikePostResponse) Reset() {
@@ -206,18 +393,32 @@ func (*DislikePostResponse) Descriptor() ([]byte, []int) {
 	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{3}
 }
 
-type AddCommentRequest struct {
+func (x *DislikePostResponse) GetPreviousVote() VoteState {
+	if x != nil {
+		return x.PreviousVote
+	}
+	return VoteState_VOTE_STATE_NONE
+}
+
+func (x *DislikePostResponse) GetCurrentVote() VoteState {
+	if x != nil {
+		return x.CurrentVote
+	}
+	return VoteState_VOTE_STATE_NONE
+}
+
+type RemoveVoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Text   string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId         int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *AddCommentRequest) Reset() {
-	*x = AddCommentRequest{}
+func (x *RemoveVoteRequest) Reset() {
+	*x = RemoveVoteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -225,13 +426,13 @@ func (x *AddCommentRequest) Reset() {
 	}
 }
 
-func (x *AddCommentRequest) String() string {
+func (x *RemoveVoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCommentRequest) ProtoMessage() {}
+func (*RemoveVoteRequest) ProtoMessage() {}
 
-func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveVoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -243,42 +444,43 @@ func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
-func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveVoteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveVoteRequest) Descriptor() ([]byte, []int) {
 	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AddCommentRequest) GetUserId() int64 {
+func (x *RemoveVoteRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *AddCommentRequest) GetPostId() int64 {
+func (x *RemoveVoteRequest) GetPostId() int64 {
 	if x != nil {
 		return x.PostId
 	}
 	return 0
 }
 
-func (x *AddCommentRequest) GetText() string {
+func (x *RemoveVoteRequest) GetIdempotencyKey() string {
 	if x != nil {
-		return x.Text
+		return x.IdempotencyKey
 	}
 	return ""
 }
 
-type AddCommentResponse struct {
+type RemoveVoteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	PreviousVote VoteState `protobuf:"varint,1,opt,name=previous_vote,json=previousVote,proto3,enum=interaction.v1.VoteState" json:"previous_vote,omitempty"`
+	CurrentVote  VoteState `protobuf:"varint,2,opt,name=current_vote,json=currentVote,proto3,enum=interaction.v1.VoteState" json:"current_vote,omitempty"` // всегда VOTE_STATE_NONE
 }
 
-func (x *AddCommentResponse) Reset() {
-	*x = AddCommentResponse{}
+func (x *RemoveVoteResponse) Reset() {
+	*x = RemoveVoteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -286,13 +488,13 @@ func (x *AddCommentResponse) Reset() {
 	}
 }
 
-func (x *AddCommentResponse) String() string {
+func (x *RemoveVoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCommentResponse) ProtoMessage() {}
+func (*RemoveVoteResponse) ProtoMessage() {}
 
-func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveVoteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -304,29 +506,39 @@ func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCommentResponse.ProtoReflect.Descriptor instead.
-func (*AddCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveVoteResponse.ProtoReflect.Descriptor instead.
+func (*RemoveVoteResponse) Descriptor() ([]byte, []int) {
 	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AddCommentResponse) GetCommentId() int64 {
+func (x *RemoveVoteResponse) GetPreviousVote() VoteState {
 	if x != nil {
-		return x.CommentId
+		return x.PreviousVote
 	}
-	return 0
+	return VoteState_VOTE_STATE_NONE
 }
 
-type GetPostStatsRequest struct {
+func (x *RemoveVoteResponse) GetCurrentVote() VoteState {
+	if x != nil {
+		return x.CurrentVote
+	}
+	return VoteState_VOTE_STATE_NONE
+}
+
+// Реакция хранится отдельно от лайка/дизлайка; у пользователя одна реакция на пост.
+type ReactToPostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId         int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reaction       string `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"` // пустая строка снимает реакцию
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *GetPostStatsRequest) Reset() {
-	*x = GetPostStatsRequest{}
+func (x *ReactToPostRequest) Reset() {
+	*x = ReactToPostRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -334,13 +546,13 @@ func (x *GetPostStatsRequest) Reset() {
 	}
 }
 
-func (x *GetPostStatsRequest) String() string {
+func (x *ReactToPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostStatsRequest) ProtoMessage() {}
+func (*ReactToPostRequest) ProtoMessage() {}
 
-func (x *GetPostStatsRequest) ProtoReflect() protoreflect.Message {
+func (x *ReactToPostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -352,38 +564,50 @@ func (x *GetPostStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetPostStatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReactToPostRequest.ProtoReflect.Descriptor instead.
+func (*ReactToPostRequest) Descriptor() ([]byte, []int) {
 	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetPostStatsRequest) GetUserId() int64 {
+func (x *ReactToPostRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetPostStatsRequest) GetPostId() int64 {
+func (x *ReactToPostRequest) GetPostId() int64 {
 	if x != nil {
 		return x.PostId
 	}
 	return 0
 }
 
-type GetPostStatsResponse struct {
+func (x *ReactToPostRequest) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *ReactToPostRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ReactToPostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Likes    int64 `protobuf:"varint,1,opt,name=likes,proto3" json:"likes,omitempty"`
-	Dislikes int64 `protobuf:"varint,2,opt,name=dislikes,proto3" json:"dislikes,omitempty"`
-	Comments int64 `protobuf:"varint,3,opt,name=comments,proto3" json:"comments,omitempty"`
-	Views    int64 `protobuf:"varint,4,opt,name=views,proto3" json:"views,omitempty"`
+	PreviousReaction string `protobuf:"bytes,1,opt,name=previous_reaction,json=previousReaction,proto3" json:"previous_reaction,omitempty"`
+	Reaction         string `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
 }
 
-func (x *GetPostStatsResponse) Reset() {
-	*x = GetPostStatsResponse{}
+func (x *ReactToPostResponse) Reset() {
+	*x = ReactToPostResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -391,13 +615,13 @@ func (x *GetPostStatsResponse) Reset() {
 	}
 }
 
-func (x *GetPostStatsResponse) String() string {
+func (x *ReactToPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostStatsResponse) ProtoMessage() {}
+func (*ReactToPostResponse) ProtoMessage() {}
 
-func (x *GetPostStatsResponse) ProtoReflect() protoreflect.Message {
+func (x *ReactToPostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -409,52 +633,39 @@ func (x *GetPostStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetPostStatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReactToPostResponse.ProtoReflect.Descriptor instead.
+func (*ReactToPostResponse) Descriptor() ([]byte, []int) {
 	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetPostStatsResponse) GetLikes() int64 {
-	if x != nil {
-		return x.Likes
-	}
-	return 0
-}
-
-func (x *GetPostStatsResponse) GetDislikes() int64 {
-	if x != nil {
-		return x.Dislikes
-	}
-	return 0
-}
-
-func (x *GetPostStatsResponse) GetComments() int64 {
+func (x *ReactToPostResponse) GetPreviousReaction() string {
 	if x != nil {
-		return x.Comments
+		return x.PreviousReaction
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPostStatsResponse) GetViews() int64 {
+func (x *ReactToPostResponse) GetReaction() string {
 	if x != nil {
-		return x.Views
+		return x.Reaction
 	}
-	return 0
+	return ""
 }
 
-type ListPostCommentsRequest struct {
+type AddCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId   int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Page     int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	UserId          int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId          int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Text            string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	ParentCommentId int64  `protobuf:"varint,4,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"` // 0 — комментарий к посту, иначе ответ
+	IdempotencyKey  string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *ListPostCommentsRequest) Reset() {
-	*x = ListPostCommentsRequest{}
+func (x *AddCommentRequest) Reset() {
+	*x = AddCommentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -462,13 +673,13 @@ func (x *ListPostCommentsRequest) Reset() {
 	}
 }
 
-func (x *ListPostCommentsRequest) String() string {
+func (x *AddCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPostCommentsRequest) ProtoMessage() {}
+func (*AddCommentRequest) ProtoMessage() {}
 
-func (x *ListPostCommentsRequest) ProtoReflect() protoreflect.Message {
+func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,51 +691,56 @@ func (x *ListPostCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPostCommentsRequest.ProtoReflect.Descriptor instead.
-func (*ListPostCommentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
 	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListPostCommentsRequest) GetUserId() int64 {
+func (x *AddCommentRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *ListPostCommentsRequest) GetPostId() int64 {
+func (x *AddCommentRequest) GetPostId() int64 {
 	if x != nil {
 		return x.PostId
 	}
 	return 0
 }
 
-func (x *ListPostCommentsRequest) GetPage() int32 {
+func (x *AddCommentRequest) GetText() string {
 	if x != nil {
-		return x.Page
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-func (x *ListPostCommentsRequest) GetPageSize() int32 {
+func (x *AddCommentRequest) GetParentCommentId() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.ParentCommentId
 	}
 	return 0
 }
 
-type CommentItem struct {
+func (x *AddCommentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type AddCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentId int64  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	Text      string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	CreatedAt string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
 }
 
-func (x *CommentItem) Reset() {
-	*x = CommentItem{}
+func (x *AddCommentResponse) Reset() {
+	*x = AddCommentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,13 +748,13 @@ func (x *CommentItem) Reset() {
 	}
 }
 
-func (x *CommentItem) String() string {
+func (x *AddCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentItem) ProtoMessage() {}
+func (*AddCommentResponse) ProtoMessage() {}
 
-func (x *CommentItem) ProtoReflect() protoreflect.Message {
+func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -550,45 +766,31 @@ func (x *CommentItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentItem.ProtoReflect.Descriptor instead.
-func (*CommentItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddCommentResponse.ProtoReflect.Descriptor instead.
+func (*AddCommentResponse) Descriptor() ([]byte, []int) {
 	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CommentItem) GetCommentId() int64 {
+func (x *AddCommentResponse) GetCommentId() int64 {
 	if x != nil {
 		return x.CommentId
 	}
 	return 0
 }
 
-func (x *CommentItem) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
-}
-
-func (x *CommentItem) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-type ListPostCommentsResponse struct {
+type EditCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comments []*CommentItem `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
-	Page     int32          `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize int32          `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	HasMore  bool           `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommentId      int64  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Text           string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *ListPostCommentsResponse) Reset() {
-	*x = ListPostCommentsResponse{}
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -596,13 +798,13 @@ func (x *ListPostCommentsResponse) Reset() {
 	}
 }
 
-func (x *ListPostCommentsResponse) String() string {
+func (x *EditCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPostCommentsResponse) ProtoMessage() {}
+func (*EditCommentRequest) ProtoMessage() {}
 
-func (x *ListPostCommentsResponse) ProtoReflect() protoreflect.Message {
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -614,50 +816,49 @@ func (x *ListPostCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPostCommentsResponse.ProtoReflect.Descriptor instead.
-func (*ListPostCommentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
 	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListPostCommentsResponse) GetComments() []*CommentItem {
+func (x *EditCommentRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Comments
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListPostCommentsResponse) GetPage() int32 {
+func (x *EditCommentRequest) GetCommentId() int64 {
 	if x != nil {
-		return x.Page
+		return x.CommentId
 	}
 	return 0
 }
 
-func (x *ListPostCommentsResponse) GetPageSize() int32 {
+func (x *EditCommentRequest) GetText() string {
 	if x != nil {
-		return x.PageSize
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-func (x *ListPostCommentsResponse) GetHasMore() bool {
+func (x *EditCommentRequest) GetIdempotencyKey() string {
 	if x != nil {
-		return x.HasMore
+		return x.IdempotencyKey
 	}
-	return false
+	return ""
 }
 
-type MarkPostViewedRequest struct {
+type EditCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	EditedAt string `protobuf:"bytes,1,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
 }
 
-func (x *MarkPostViewedRequest) Reset() {
-	*x = MarkPostViewedRequest{}
+func (x *EditCommentResponse) Reset() {
+	*x = EditCommentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -665,13 +866,13 @@ func (x *MarkPostViewedRequest) Reset() {
 	}
 }
 
-func (x *MarkPostViewedRequest) String() string {
+func (x *EditCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkPostViewedRequest) ProtoMessage() {}
+func (*EditCommentResponse) ProtoMessage() {}
 
-func (x *MarkPostViewedRequest) ProtoReflect() protoreflect.Message {
+func (x *EditCommentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -683,231 +884,2639 @@ func (x *MarkPostViewedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkPostViewedRequest.ProtoReflect.Descriptor instead.
-func (*MarkPostViewedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EditCommentResponse.ProtoReflect.Descriptor instead.
+func (*EditCommentResponse) Descriptor() ([]byte, []int) {
 	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *MarkPostViewedRequest) GetUserId() int64 {
+func (x *EditCommentResponse) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
+
+// Удалить комментарий может его автор или автор поста.
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommentId      int64  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteCommentRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *MarkPostViewedRequest) GetPostId() int64 {
+func (x *DeleteCommentRequest) GetCommentId() int64 {
 	if x != nil {
-		return x.PostId
+		return x.CommentId
 	}
 	return 0
 }
 
-type MarkPostViewedResponse struct {
+func (x *DeleteCommentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type DeleteCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MarkPostViewedResponse) Reset() {
-	*x = MarkPostViewedResponse{}
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[12]
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarkPostViewedResponse) String() string {
+func (x *DeleteCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkPostViewedResponse) ProtoMessage() {}
+func (*DeleteCommentResponse) ProtoMessage() {}
 
-func (x *MarkPostViewedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[12]
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{13}
+}
+
+type LikeCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommentId      int64  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *LikeCommentRequest) Reset() {
+	*x = LikeCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikeCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeCommentRequest) ProtoMessage() {}
+
+func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
+func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LikeCommentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LikeCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *LikeCommentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type LikeCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousVote VoteState `protobuf:"varint,1,opt,name=previous_vote,json=previousVote,proto3,enum=interaction.v1.VoteState" json:"previous_vote,omitempty"`
+	CurrentVote  VoteState `protobuf:"varint,2,opt,name=current_vote,json=currentVote,proto3,enum=interaction.v1.VoteState" json:"current_vote,omitempty"`
+}
+
+func (x *LikeCommentResponse) Reset() {
+	*x = LikeCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikeCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeCommentResponse) ProtoMessage() {}
+
+func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeCommentResponse.ProtoReflect.Descriptor instead.
+func (*LikeCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LikeCommentResponse) GetPreviousVote() VoteState {
+	if x != nil {
+		return x.PreviousVote
+	}
+	return VoteState_VOTE_STATE_NONE
+}
+
+func (x *LikeCommentResponse) GetCurrentVote() VoteState {
+	if x != nil {
+		return x.CurrentVote
+	}
+	return VoteState_VOTE_STATE_NONE
+}
+
+type DislikeCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommentId      int64  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *DislikeCommentRequest) Reset() {
+	*x = DislikeCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DislikeCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DislikeCommentRequest) ProtoMessage() {}
+
+func (x *DislikeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DislikeCommentRequest.ProtoReflect.Descriptor instead.
+func (*DislikeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DislikeCommentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DislikeCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *DislikeCommentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type DislikeCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousVote VoteState `protobuf:"varint,1,opt,name=previous_vote,json=previousVote,proto3,enum=interaction.v1.VoteState" json:"previous_vote,omitempty"`
+	CurrentVote  VoteState `protobuf:"varint,2,opt,name=current_vote,json=currentVote,proto3,enum=interaction.v1.VoteState" json:"current_vote,omitempty"`
+}
+
+func (x *DislikeCommentResponse) Reset() {
+	*x = DislikeCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DislikeCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DislikeCommentResponse) ProtoMessage() {}
+
+func (x *DislikeCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MarkPostViewedResponse.ProtoReflect.Descriptor instead.
-func (*MarkPostViewedResponse) Descriptor() ([]byte, []int) {
-	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{12}
-}
-
-var File_proto_interaction_v1_interaction_proto protoreflect.FileDescriptor
-
-var file_proto_interaction_v1_interaction_proto_rawDesc = []byte{
-	0x0a, 0x26, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x22, 0x43, 0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x12, 0x0a,
-	0x10, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x46, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x69, 0x73,
-	0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x59, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x33, 0x0a, 0x12, 0x41,
-	0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69,
-	0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69,
-	0x6b, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x7c, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0x5f, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x68,
-	0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68,
-	0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x49, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x6f,
-	0x73, 0x74, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x64, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x65,
-	0x77, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb3, 0x04, 0x0a, 0x12,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1f,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x56, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x22, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x41, 0x64, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5f, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x65, 0x77,
-	0x65, 0x64, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x65, 0x77,
-	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x50,
-	0x6f, 0x73, 0x74, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x65, 0x74, 0x2f, 0x67, 0x65,
-	0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_interaction_v1_interaction_proto_rawDescOnce sync.Once
-	file_proto_interaction_v1_interaction_proto_rawDescData = file_proto_interaction_v1_interaction_proto_rawDesc
-)
-
-func file_proto_interaction_v1_interaction_proto_rawDescGZIP() []byte {
-	file_proto_interaction_v1_interaction_proto_rawDescOnce.Do(func() {
-		file_proto_interaction_v1_interaction_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_interaction_v1_interaction_proto_rawDescData)
-	})
-	return file_proto_interaction_v1_interaction_proto_rawDescData
-}
-
-var file_proto_interaction_v1_interaction_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_proto_interaction_v1_interaction_proto_goTypes = []interface{}{
-	(*LikePostRequest)(nil),          // 0: interaction.v1.LikePostRequest
-	(*LikePostResponse)(nil),         // 1: interaction.v1.LikePostResponse
-	(*DislikePostRequest)(nil),       // 2: interaction.v1.DislikePostRequest
-	(*DislikePostResponse)(nil),      // 3: interaction.v1.DislikePostResponse
-	(*AddCommentRequest)(nil),        // 4: interaction.v1.AddCommentRequest
-	(*AddCommentResponse)(nil),       // 5: interaction.v1.AddCommentResponse
-	(*GetPostStatsRequest)(nil),      // 6: interaction.v1.GetPostStatsRequest
-	(*GetPostStatsResponse)(nil),     // 7: interaction.v1.GetPostStatsResponse
-	(*ListPostCommentsRequest)(nil),  // 8: interaction.v1.ListPostCommentsRequest
-	(*CommentItem)(nil),              // 9: interaction.v1.CommentItem
-	(*ListPostCommentsResponse)(nil), // 10: interaction.v1.ListPostCommentsResponse
-	(*MarkPostViewedRequest)(nil),    // 11: interaction.v1.MarkPostViewedRequest
-	(*MarkPostViewedResponse)(nil),   // 12: interaction.v1.MarkPostViewedResponse
-}
-var file_proto_interaction_v1_interaction_proto_depIdxs = []int32{
-	9,  // 0: interaction.v1.ListPostCommentsResponse.comments:type_name -> interaction.v1.CommentItem
-	0,  // 1: interaction.v1.InteractionService.LikePost:input_type -> interaction.v1.LikePostRequest
-	2,  // 2: interaction.v1.InteractionService.DislikePost:input_type -> interaction.v1.DislikePostRequest
-	4,  // 3: interaction.v1.InteractionService.AddComment:input_type -> interaction.v1.AddCommentRequest
-	6,  // 4: interaction.v1.InteractionService.GetPostStats:input_type -> interaction.v1.GetPostStatsRequest
-	8,  // 5: interaction.v1.InteractionService.ListPostComments:input_type -> interaction.v1.ListPostCommentsRequest
-	11, // 6: interaction.v1.InteractionService.MarkPostViewed:input_type -> interaction.v1.MarkPostViewedRequest
-	1,  // 7: interaction.v1.InteractionService.LikePost:output_type -> interaction.v1.LikePostResponse
-	3,  // 8: interaction.v1.InteractionService.DislikePost:output_type -> interaction.v1.DislikePostResponse
-	5,  // 9: interaction.v1.InteractionService.AddComment:output_type -> interaction.v1.AddCommentResponse
-	7,  // 10: interaction.v1.InteractionService.GetPostStats:output_type -> interaction.v1.GetPostStatsResponse
-	10, // 11: interaction.v1.InteractionService.ListPostComments:output_type -> interaction.v1.ListPostCommentsResponse
-	12, // 12: interaction.v1.InteractionService.MarkPostViewed:output_type -> interaction.v1.MarkPostViewedResponse
-	7,  // [7:13] is the sub-list for method output_type
-	1,  // [1:7] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
-}
-
-func init() { file_proto_interaction_v1_interaction_proto_init() }
-func file_proto_interaction_v1_interaction_proto_init() {
-	if File_proto_interaction_v1_interaction_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_interaction_v1_interaction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikePostRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DislikeCommentResponse.ProtoReflect.Descriptor instead.
+func (*DislikeCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DislikeCommentResponse) GetPreviousVote() VoteState {
+	if x != nil {
+		return x.PreviousVote
+	}
+	return VoteState_VOTE_STATE_NONE
+}
+
+func (x *DislikeCommentResponse) GetCurrentVote() VoteState {
+	if x != nil {
+		return x.CurrentVote
+	}
+	return VoteState_VOTE_STATE_NONE
+}
+
+type GetPostStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId         int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	IncludeReposts bool  `protobuf:"varint,3,opt,name=include_reposts,json=includeReposts,proto3" json:"include_reposts,omitempty"` // суммировать счётчики репостов в оригинал
+}
+
+func (x *GetPostStatsRequest) Reset() {
+	*x = GetPostStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostStatsRequest) ProtoMessage() {}
+
+func (x *GetPostStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPostStatsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPostStatsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetPostStatsRequest) GetIncludeReposts() bool {
+	if x != nil {
+		return x.IncludeReposts
+	}
+	return false
+}
+
+type GetPostStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Likes     int64            `protobuf:"varint,1,opt,name=likes,proto3" json:"likes,omitempty"`
+	Dislikes  int64            `protobuf:"varint,2,opt,name=dislikes,proto3" json:"dislikes,omitempty"`
+	Comments  int64            `protobuf:"varint,3,opt,name=comments,proto3" json:"comments,omitempty"`
+	Views     int64            `protobuf:"varint,4,opt,name=views,proto3" json:"views,omitempty"`
+	Reposts   int64            `protobuf:"varint,5,opt,name=reposts,proto3" json:"reposts,omitempty"`
+	Reactions map[string]int64 `protobuf:"bytes,6,rep,name=reactions,proto3" json:"reactions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // эмодзи -> число реакций
+	Saves     int64            `protobuf:"varint,7,opt,name=saves,proto3" json:"saves,omitempty"`                                                                                                 // сколько пользователей сохранили пост; кто именно — не раскрывается
+}
+
+func (x *GetPostStatsResponse) Reset() {
+	*x = GetPostStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostStatsResponse) ProtoMessage() {}
+
+func (x *GetPostStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPostStatsResponse) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *GetPostStatsResponse) GetDislikes() int64 {
+	if x != nil {
+		return x.Dislikes
+	}
+	return 0
+}
+
+func (x *GetPostStatsResponse) GetComments() int64 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *GetPostStatsResponse) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *GetPostStatsResponse) GetReposts() int64 {
+	if x != nil {
+		return x.Reposts
+	}
+	return 0
+}
+
+func (x *GetPostStatsResponse) GetReactions() map[string]int64 {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *GetPostStatsResponse) GetSaves() int64 {
+	if x != nil {
+		return x.Saves
+	}
+	return 0
+}
+
+type ListPostCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64         `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId    int64         `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Page      int32         `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"` // устарело: используйте page_token
+	PageSize  int32         `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string        `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Layout    CommentLayout `protobuf:"varint,6,opt,name=layout,proto3,enum=interaction.v1.CommentLayout" json:"layout,omitempty"`
+	Sort      CommentSort   `protobuf:"varint,7,opt,name=sort,proto3,enum=interaction.v1.CommentSort" json:"sort,omitempty"` // в дереве сортируются корневые комментарии, ответы идут от старых к новым
+}
+
+func (x *ListPostCommentsRequest) Reset() {
+	*x = ListPostCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPostCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostCommentsRequest) ProtoMessage() {}
+
+func (x *ListPostCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListPostCommentsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListPostCommentsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ListPostCommentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPostCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPostCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPostCommentsRequest) GetLayout() CommentLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return CommentLayout_COMMENT_LAYOUT_FLAT
+}
+
+func (x *ListPostCommentsRequest) GetSort() CommentSort {
+	if x != nil {
+		return x.Sort
+	}
+	return CommentSort_COMMENT_SORT_NEW
+}
+
+type CommentItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId       int64          `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Text            string         `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt       string         `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt        string         `protobuf:"bytes,4,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"` // пусто, если комментарий не редактировался
+	ParentCommentId int64          `protobuf:"varint,5,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
+	Depth           int32          `protobuf:"varint,6,opt,name=depth,proto3" json:"depth,omitempty"`
+	Replies         []*CommentItem `protobuf:"bytes,7,rep,name=replies,proto3" json:"replies,omitempty"`                                        // только для COMMENT_LAYOUT_TREE
+	Deleted         bool           `protobuf:"varint,8,opt,name=deleted,proto3" json:"deleted,omitempty"`                                       // удалённый комментарий, оставленный ради ответов на него
+	AuthorPseudonym string         `protobuf:"bytes,9,opt,name=author_pseudonym,json=authorPseudonym,proto3" json:"author_pseudonym,omitempty"` // постоянный и уникальный в пределах поста; "OP" для автора поста
+	Likes           int64          `protobuf:"varint,10,opt,name=likes,proto3" json:"likes,omitempty"`
+	Dislikes        int64          `protobuf:"varint,11,opt,name=dislikes,proto3" json:"dislikes,omitempty"`
+	Score           int64          `protobuf:"varint,12,opt,name=score,proto3" json:"score,omitempty"` // likes - dislikes
+}
+
+func (x *CommentItem) Reset() {
+	*x = CommentItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentItem) ProtoMessage() {}
+
+func (x *CommentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentItem.ProtoReflect.Descriptor instead.
+func (*CommentItem) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CommentItem) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *CommentItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CommentItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CommentItem) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
+
+func (x *CommentItem) GetParentCommentId() int64 {
+	if x != nil {
+		return x.ParentCommentId
+	}
+	return 0
+}
+
+func (x *CommentItem) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *CommentItem) GetReplies() []*CommentItem {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *CommentItem) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *CommentItem) GetAuthorPseudonym() string {
+	if x != nil {
+		return x.AuthorPseudonym
+	}
+	return ""
+}
+
+func (x *CommentItem) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *CommentItem) GetDislikes() int64 {
+	if x != nil {
+		return x.Dislikes
+	}
+	return 0
+}
+
+func (x *CommentItem) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ListPostCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments      []*CommentItem `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Page          int32          `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32          `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	HasMore       bool           `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextPageToken string         `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListPostCommentsResponse) Reset() {
+	*x = ListPostCommentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPostCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostCommentsResponse) ProtoMessage() {}
+
+func (x *ListPostCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListPostCommentsResponse) GetComments() []*CommentItem {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListPostCommentsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPostCommentsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPostCommentsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListPostCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MarkPostViewedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *MarkPostViewedRequest) Reset() {
+	*x = MarkPostViewedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkPostViewedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPostViewedRequest) ProtoMessage() {}
+
+func (x *MarkPostViewedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPostViewedRequest.ProtoReflect.Descriptor instead.
+func (*MarkPostViewedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MarkPostViewedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MarkPostViewedRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type MarkPostViewedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MarkPostViewedResponse) Reset() {
+	*x = MarkPostViewedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkPostViewedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPostViewedResponse) ProtoMessage() {}
+
+func (x *MarkPostViewedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPostViewedResponse.ProtoReflect.Descriptor instead.
+func (*MarkPostViewedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{24}
+}
+
+// RecordEngagementRequest описывает один показ поста в ленте.
+type RecordEngagementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId         int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	DwellMs        int64 `protobuf:"varint,3,opt,name=dwell_ms,json=dwellMs,proto3" json:"dwell_ms,omitempty"` // время на экране до следующего действия; большие значения обрезаются
+	CommentsOpened bool  `protobuf:"varint,4,opt,name=comments_opened,json=commentsOpened,proto3" json:"comments_opened,omitempty"`
+}
+
+func (x *RecordEngagementRequest) Reset() {
+	*x = RecordEngagementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordEngagementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEngagementRequest) ProtoMessage() {}
+
+func (x *RecordEngagementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEngagementRequest.ProtoReflect.Descriptor instead.
+func (*RecordEngagementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RecordEngagementRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecordEngagementRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *RecordEngagementRequest) GetDwellMs() int64 {
+	if x != nil {
+		return x.DwellMs
+	}
+	return 0
+}
+
+func (x *RecordEngagementRequest) GetCommentsOpened() bool {
+	if x != nil {
+		return x.CommentsOpened
+	}
+	return false
+}
+
+type RecordEngagementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Skipped bool  `protobuf:"varint,1,opt,name=skipped,proto3" json:"skipped,omitempty"`                // пост пролистан быстрее порога и без открытия комментариев
+	DwellMs int64 `protobuf:"varint,2,opt,name=dwell_ms,json=dwellMs,proto3" json:"dwell_ms,omitempty"` // учтённое время после обрезки
+}
+
+func (x *RecordEngagementResponse) Reset() {
+	*x = RecordEngagementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordEngagementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEngagementResponse) ProtoMessage() {}
+
+func (x *RecordEngagementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEngagementResponse.ProtoReflect.Descriptor instead.
+func (*RecordEngagementResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RecordEngagementResponse) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *RecordEngagementResponse) GetDwellMs() int64 {
+	if x != nil {
+		return x.DwellMs
+	}
+	return 0
+}
+
+type BatchGetPostStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostIds []int64 `protobuf:"varint,1,rep,packed,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"` // не больше 100
+}
+
+func (x *BatchGetPostStatsRequest) Reset() {
+	*x = BatchGetPostStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetPostStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPostStatsRequest) ProtoMessage() {}
+
+func (x *BatchGetPostStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPostStatsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetPostStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BatchGetPostStatsRequest) GetPostIds() []int64 {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+type BatchGetPostStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats          map[int64]*GetPostStatsResponse `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MissingPostIds []int64                         `protobuf:"varint,2,rep,packed,name=missing_post_ids,json=missingPostIds,proto3" json:"missing_post_ids,omitempty"`
+}
+
+func (x *BatchGetPostStatsResponse) Reset() {
+	*x = BatchGetPostStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetPostStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPostStatsResponse) ProtoMessage() {}
+
+func (x *BatchGetPostStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPostStatsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetPostStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BatchGetPostStatsResponse) GetStats() map[int64]*GetPostStatsResponse {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *BatchGetPostStatsResponse) GetMissingPostIds() []int64 {
+	if x != nil {
+		return x.MissingPostIds
+	}
+	return nil
+}
+
+type VotePollRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId         int64   `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	OptionIds      []int64 `protobuf:"varint,3,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+	IdempotencyKey string  `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VotePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollRequest) ProtoMessage() {}
+
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VotePollRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VotePollRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *VotePollRequest) GetOptionIds() []int64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+func (x *VotePollRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type VotePollResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results *GetPollResultsResponse `protobuf:"bytes,1,opt,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *VotePollResponse) Reset() {
+	*x = VotePollResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VotePollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollResponse) ProtoMessage() {}
+
+func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollResponse.ProtoReflect.Descriptor instead.
+func (*VotePollResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VotePollResponse) GetResults() *GetPollResultsResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetPollResultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *GetPollResultsRequest) Reset() {
+	*x = GetPollResultsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPollResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollResultsRequest) ProtoMessage() {}
+
+func (x *GetPollResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollResultsRequest.ProtoReflect.Descriptor instead.
+func (*GetPollResultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetPollResultsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPollResultsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type PollOptionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OptionId int64  `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Votes    int64  `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`     // 0, пока результаты скрыты
+	Percent  int32  `protobuf:"varint,4,opt,name=percent,proto3" json:"percent,omitempty"` // доля проголосовавших, выбравших вариант
+	Chosen   bool   `protobuf:"varint,5,opt,name=chosen,proto3" json:"chosen,omitempty"`
+}
+
+func (x *PollOptionResult) Reset() {
+	*x = PollOptionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollOptionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollOptionResult) ProtoMessage() {}
+
+func (x *PollOptionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollOptionResult.ProtoReflect.Descriptor instead.
+func (*PollOptionResult) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PollOptionResult) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *PollOptionResult) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PollOptionResult) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *PollOptionResult) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *PollOptionResult) GetChosen() bool {
+	if x != nil {
+		return x.Chosen
+	}
+	return false
+}
+
+type GetPollResultsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Question       string              `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	MultipleChoice bool                `protobuf:"varint,2,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"`
+	ResultsVisible bool                `protobuf:"varint,3,opt,name=results_visible,json=resultsVisible,proto3" json:"results_visible,omitempty"` // false, пока пользователь не проголосовал
+	TotalVoters    int64               `protobuf:"varint,4,opt,name=total_voters,json=totalVoters,proto3" json:"total_voters,omitempty"`
+	Options        []*PollOptionResult `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *GetPollResultsResponse) Reset() {
+	*x = GetPollResultsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPollResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollResultsResponse) ProtoMessage() {}
+
+func (x *GetPollResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollResultsResponse.ProtoReflect.Descriptor instead.
+func (*GetPollResultsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetPollResultsResponse) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *GetPollResultsResponse) GetMultipleChoice() bool {
+	if x != nil {
+		return x.MultipleChoice
+	}
+	return false
+}
+
+func (x *GetPollResultsResponse) GetResultsVisible() bool {
+	if x != nil {
+		return x.ResultsVisible
+	}
+	return false
+}
+
+func (x *GetPollResultsResponse) GetTotalVoters() int64 {
+	if x != nil {
+		return x.TotalVoters
+	}
+	return 0
+}
+
+func (x *GetPollResultsResponse) GetOptions() []*PollOptionResult {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type SavePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId         int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *SavePostRequest) Reset() {
+	*x = SavePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePostRequest) ProtoMessage() {}
+
+func (x *SavePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePostRequest.ProtoReflect.Descriptor instead.
+func (*SavePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SavePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SavePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SavePostRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type SavePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AlreadySaved bool `protobuf:"varint,1,opt,name=already_saved,json=alreadySaved,proto3" json:"already_saved,omitempty"`
+}
+
+func (x *SavePostResponse) Reset() {
+	*x = SavePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePostResponse) ProtoMessage() {}
+
+func (x *SavePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePostResponse.ProtoReflect.Descriptor instead.
+func (*SavePostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SavePostResponse) GetAlreadySaved() bool {
+	if x != nil {
+		return x.AlreadySaved
+	}
+	return false
+}
+
+type UnsavePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId         int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *UnsavePostRequest) Reset() {
+	*x = UnsavePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsavePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsavePostRequest) ProtoMessage() {}
+
+func (x *UnsavePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsavePostRequest.ProtoReflect.Descriptor instead.
+func (*UnsavePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UnsavePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnsavePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *UnsavePostRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type UnsavePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Removed bool `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"` // false, если поста не было в закладках
+}
+
+func (x *UnsavePostResponse) Reset() {
+	*x = UnsavePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsavePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsavePostResponse) ProtoMessage() {}
+
+func (x *UnsavePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsavePostResponse.ProtoReflect.Descriptor instead.
+func (*UnsavePostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UnsavePostResponse) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+type ListSavedPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // по умолчанию 10, не больше 50
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListSavedPostsRequest) Reset() {
+	*x = ListSavedPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSavedPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedPostsRequest) ProtoMessage() {}
+
+func (x *ListSavedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListSavedPostsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSavedPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSavedPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SavedPost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      int64  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	TextPreview string `protobuf:"bytes,2,opt,name=text_preview,json=textPreview,proto3" json:"text_preview,omitempty"`
+	MediaType   string `protobuf:"bytes,3,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	SavedAt     string `protobuf:"bytes,4,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"`
+}
+
+func (x *SavedPost) Reset() {
+	*x = SavedPost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavedPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedPost) ProtoMessage() {}
+
+func (x *SavedPost) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedPost.ProtoReflect.Descriptor instead.
+func (*SavedPost) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SavedPost) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SavedPost) GetTextPreview() string {
+	if x != nil {
+		return x.TextPreview
+	}
+	return ""
+}
+
+func (x *SavedPost) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *SavedPost) GetSavedAt() string {
+	if x != nil {
+		return x.SavedAt
+	}
+	return ""
+}
+
+type ListSavedPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts         []*SavedPost `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"` // от недавно сохранённых; удалённые после сохранения посты пропускаются
+	HasMore       bool         `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextPageToken string       `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListSavedPostsResponse) Reset() {
+	*x = ListSavedPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_interaction_v1_interaction_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSavedPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedPostsResponse) ProtoMessage() {}
+
+func (x *ListSavedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_interaction_v1_interaction_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_interaction_v1_interaction_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListSavedPostsResponse) GetPosts() []*SavedPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListSavedPostsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListSavedPostsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_proto_interaction_v1_interaction_proto protoreflect.FileDescriptor
+
+var file_proto_interaction_v1_interaction_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x22, 0x6c, 0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x90, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x6b, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x6f, 0x0a, 0x12, 0x44, 0x69, 0x73,
+	0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x44,
+	0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76,
+	0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x6f,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65,
+	0x22, 0x6e, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x22, 0x92, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x63, 0x74, 0x54,
+	0x6f, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4b, 0x65, 0x79, 0x22, 0x5e, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xae, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x4b, 0x65, 0x79, 0x22, 0x33, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x45, 0x64,
+	0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x32, 0x0a, 0x13, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x77, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x12, 0x4c,
+	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x78, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x6c,
+	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x22, 0x96, 0x01, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x3c, 0x0a,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x70, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xbb, 0x02,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x74, 0x73, 0x12, 0x51, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x61, 0x76, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x61, 0x76, 0x65, 0x73, 0x1a, 0x3c, 0x0a,
+	0x0e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x83, 0x02, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x12, 0x2f, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x22, 0x82, 0x03, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x73,
+	0x65, 0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x50, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6b, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x49, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x4d,
+	0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x5f, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x4d, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x22, 0x4f, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x4d, 0x73, 0x22, 0x35, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22,
+	0xf1, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x73, 0x1a, 0x5e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x22, 0x54, 0x0a, 0x10, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x6c, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x73,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e,
+	0x22, 0xe5, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x6c, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6c, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x37, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x53, 0x61, 0x76, 0x65, 0x64, 0x22,
+	0x6e, 0x0a, 0x11, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22,
+	0x2e, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22,
+	0x6c, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x81, 0x01,
+	0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x61, 0x76, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x8c, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76,
+	0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2a, 0x4d, 0x0a, 0x09, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x13, 0x0a,
+	0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x4f, 0x54, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x02, 0x2a,
+	0x41, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x41, 0x59, 0x4f,
+	0x55, 0x54, 0x5f, 0x46, 0x4c, 0x41, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4d,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x54, 0x52, 0x45, 0x45,
+	0x10, 0x01, 0x2a, 0x6f, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72,
+	0x74, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52,
+	0x54, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4d, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x4f,
+	0x50, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x41,
+	0x4c, 0x10, 0x03, 0x32, 0xd5, 0x0d, 0x0a, 0x12, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x4c, 0x69,
+	0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x44, 0x69, 0x73,
+	0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x12,
+	0x21, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x63, 0x74, 0x54,
+	0x6f, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
+	0x0a, 0x0a, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x4c, 0x69, 0x6b,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6c,
+	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x23, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x27, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74,
+	0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74,
+	0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45,
+	0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x28, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f,
+	0x6c, 0x6c, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x65, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31,
+	0x3b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_interaction_v1_interaction_proto_rawDescOnce sync.Once
+	file_proto_interaction_v1_interaction_proto_rawDescData = file_proto_interaction_v1_interaction_proto_rawDesc
+)
+
+func file_proto_interaction_v1_interaction_proto_rawDescGZIP() []byte {
+	file_proto_interaction_v1_interaction_proto_rawDescOnce.Do(func() {
+		file_proto_interaction_v1_interaction_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_interaction_v1_interaction_proto_rawDescData)
+	})
+	return file_proto_interaction_v1_interaction_proto_rawDescData
+}
+
+var file_proto_interaction_v1_interaction_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_interaction_v1_interaction_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_proto_interaction_v1_interaction_proto_goTypes = []interface{}{
+	(VoteState)(0),                    // 0: interaction.v1.VoteState
+	(CommentLayout)(0),                // 1: interaction.v1.CommentLayout
+	(CommentSort)(0),                  // 2: interaction.v1.CommentSort
+	(*LikePostRequest)(nil),           // 3: interaction.v1.LikePostRequest
+	(*LikePostResponse)(nil),          // 4: interaction.v1.LikePostResponse
+	(*DislikePostRequest)(nil),        // 5: interaction.v1.DislikePostRequest
+	(*DislikePostResponse)(nil),       // 6: interaction.v1.DislikePostResponse
+	(*RemoveVoteRequest)(nil),         // 7: interaction.v1.RemoveVoteRequest
+	(*RemoveVoteResponse)(nil),        // 8: interaction.v1.RemoveVoteResponse
+	(*ReactToPostRequest)(nil),        // 9: interaction.v1.ReactToPostRequest
+	(*ReactToPostResponse)(nil),       // 10: interaction.v1.ReactToPostResponse
+	(*AddCommentRequest)(nil),         // 11: interaction.v1.AddCommentRequest
+	(*AddCommentResponse)(nil),        // 12: interaction.v1.AddCommentResponse
+	(*EditCommentRequest)(nil),        // 13: interaction.v1.EditCommentRequest
+	(*EditCommentResponse)(nil),       // 14: interaction.v1.EditCommentResponse
+	(*DeleteCommentRequest)(nil),      // 15: interaction.v1.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),     // 16: interaction.v1.DeleteCommentResponse
+	(*LikeCommentRequest)(nil),        // 17: interaction.v1.LikeCommentRequest
+	(*LikeCommentResponse)(nil),       // 18: interaction.v1.LikeCommentResponse
+	(*DislikeCommentRequest)(nil),     // 19: interaction.v1.DislikeCommentRequest
+	(*DislikeCommentResponse)(nil),    // 20: interaction.v1.DislikeCommentResponse
+	(*GetPostStatsRequest)(nil),       // 21: interaction.v1.GetPostStatsRequest
+	(*GetPostStatsResponse)(nil),      // 22: interaction.v1.GetPostStatsResponse
+	(*ListPostCommentsRequest)(nil),   // 23: interaction.v1.ListPostCommentsRequest
+	(*CommentItem)(nil),               // 24: interaction.v1.CommentItem
+	(*ListPostCommentsResponse)(nil),  // 25: interaction.v1.ListPostCommentsResponse
+	(*MarkPostViewedRequest)(nil),     // 26: interaction.v1.MarkPostViewedRequest
+	(*MarkPostViewedResponse)(nil),    // 27: interaction.v1.MarkPostViewedResponse
+	(*RecordEngagementRequest)(nil),   // 28: interaction.v1.RecordEngagementRequest
+	(*RecordEngagementResponse)(nil),  // 29: interaction.v1.RecordEngagementResponse
+	(*BatchGetPostStatsRequest)(nil),  // 30: interaction.v1.BatchGetPostStatsRequest
+	(*BatchGetPostStatsResponse)(nil), // 31: interaction.v1.BatchGetPostStatsResponse
+	(*VotePollRequest)(nil),           // 32: interaction.v1.VotePollRequest
+	(*VotePollResponse)(nil),          // 33: interaction.v1.VotePollResponse
+	(*GetPollResultsRequest)(nil),     // 34: interaction.v1.GetPollResultsRequest
+	(*PollOptionResult)(nil),          // 35: interaction.v1.PollOptionResult
+	(*GetPollResultsResponse)(nil),    // 36: interaction.v1.GetPollResultsResponse
+	(*SavePostRequest)(nil),           // 37: interaction.v1.SavePostRequest
+	(*SavePostResponse)(nil),          // 38: interaction.v1.SavePostResponse
+	(*UnsavePostRequest)(nil),         // 39: interaction.v1.UnsavePostRequest
+	(*UnsavePostResponse)(nil),        // 40: interaction.v1.UnsavePostResponse
+	(*ListSavedPostsRequest)(nil),     // 41: interaction.v1.ListSavedPostsRequest
+	(*SavedPost)(nil),                 // 42: interaction.v1.SavedPost
+	(*ListSavedPostsResponse)(nil),    // 43: interaction.v1.ListSavedPostsResponse
+	nil,                               // 44: interaction.v1.GetPostStatsResponse.ReactionsEntry
+	nil,                               // 45: interaction.v1.BatchGetPostStatsResponse.StatsEntry
+}
+var file_proto_interaction_v1_interaction_proto_depIdxs = []int32{
+	0,  // 0: interaction.v1.LikePostResponse.previous_vote:type_name -> interaction.v1.VoteState
+	0,  // 1: interaction.v1.LikePostResponse.current_vote:type_name -> interaction.v1.VoteState
+	0,  // 2: interaction.v1.DislikePostResponse.previous_vote:type_name -> interaction.v1.VoteState
+	0,  // 3: interaction.v1.DislikePostResponse.current_vote:type_name -> interaction.v1.VoteState
+	0,  // 4: interaction.v1.RemoveVoteResponse.previous_vote:type_name -> interaction.v1.VoteState
+	0,  // 5: interaction.v1.RemoveVoteResponse.current_vote:type_name -> interaction.v1.VoteState
+	0,  // 6: interaction.v1.LikeCommentResponse.previous_vote:type_name -> interaction.v1.VoteState
+	0,  // 7: interaction.v1.LikeCommentResponse.current_vote:type_name -> interaction.v1.VoteState
+	0,  // 8: interaction.v1.DislikeCommentResponse.previous_vote:type_name -> interaction.v1.VoteState
+	0,  // 9: interaction.v1.DislikeCommentResponse.current_vote:type_name -> interaction.v1.VoteState
+	44, // 10: interaction.v1.GetPostStatsResponse.reactions:type_name -> interaction.v1.GetPostStatsResponse.ReactionsEntry
+	1,  // 11: interaction.v1.ListPostCommentsRequest.layout:type_name -> interaction.v1.CommentLayout
+	2,  // 12: interaction.v1.ListPostCommentsRequest.sort:type_name -> interaction.v1.CommentSort
+	24, // 13: interaction.v1.CommentItem.replies:type_name -> interaction.v1.CommentItem
+	24, // 14: interaction.v1.ListPostCommentsResponse.comments:type_name -> interaction.v1.CommentItem
+	45, // 15: interaction.v1.BatchGetPostStatsResponse.stats:type_name -> interaction.v1.BatchGetPostStatsResponse.StatsEntry
+	36, // 16: interaction.v1.VotePollResponse.results:type_name -> interaction.v1.GetPollResultsResponse
+	35, // 17: interaction.v1.GetPollResultsResponse.options:type_name -> interaction.v1.PollOptionResult
+	42, // 18: interaction.v1.ListSavedPostsResponse.posts:type_name -> interaction.v1.SavedPost
+	22, // 19: interaction.v1.BatchGetPostStatsResponse.StatsEntry.value:type_name -> interaction.v1.GetPostStatsResponse
+	3,  // 20: interaction.v1.InteractionService.LikePost:input_type -> interaction.v1.LikePostRequest
+	5,  // 21: interaction.v1.InteractionService.DislikePost:input_type -> interaction.v1.DislikePostRequest
+	7,  // 22: interaction.v1.InteractionService.RemoveVote:input_type -> interaction.v1.RemoveVoteRequest
+	9,  // 23: interaction.v1.InteractionService.ReactToPost:input_type -> interaction.v1.ReactToPostRequest
+	11, // 24: interaction.v1.InteractionService.AddComment:input_type -> interaction.v1.AddCommentRequest
+	13, // 25: interaction.v1.InteractionService.EditComment:input_type -> interaction.v1.EditCommentRequest
+	15, // 26: interaction.v1.InteractionService.DeleteComment:input_type -> interaction.v1.DeleteCommentRequest
+	17, // 27: interaction.v1.InteractionService.LikeComment:input_type -> interaction.v1.LikeCommentRequest
+	19, // 28: interaction.v1.InteractionService.DislikeComment:input_type -> interaction.v1.DislikeCommentRequest
+	21, // 29: interaction.v1.InteractionService.GetPostStats:input_type -> interaction.v1.GetPostStatsRequest
+	23, // 30: interaction.v1.InteractionService.ListPostComments:input_type -> interaction.v1.ListPostCommentsRequest
+	26, // 31: interaction.v1.InteractionService.MarkPostViewed:input_type -> interaction.v1.MarkPostViewedRequest
+	28, // 32: interaction.v1.InteractionService.RecordEngagement:input_type -> interaction.v1.RecordEngagementRequest
+	30, // 33: interaction.v1.InteractionService.BatchGetPostStats:input_type -> interaction.v1.BatchGetPostStatsRequest
+	32, // 34: interaction.v1.InteractionService.VotePoll:input_type -> interaction.v1.VotePollRequest
+	34, // 35: interaction.v1.InteractionService.GetPollResults:input_type -> interaction.v1.GetPollResultsRequest
+	37, // 36: interaction.v1.InteractionService.SavePost:input_type -> interaction.v1.SavePostRequest
+	39, // 37: interaction.v1.InteractionService.UnsavePost:input_type -> interaction.v1.UnsavePostRequest
+	41, // 38: interaction.v1.InteractionService.ListSavedPosts:input_type -> interaction.v1.ListSavedPostsRequest
+	4,  // 39: interaction.v1.InteractionService.LikePost:output_type -> interaction.v1.LikePostResponse
+	6,  // 40: interaction.v1.InteractionService.DislikePost:output_type -> interaction.v1.DislikePostResponse
+	8,  // 41: interaction.v1.InteractionService.RemoveVote:output_type -> interaction.v1.RemoveVoteResponse
+	10, // 42: interaction.v1.InteractionService.ReactToPost:output_type -> interaction.v1.ReactToPostResponse
+	12, // 43: interaction.v1.InteractionService.AddComment:output_type -> interaction.v1.AddCommentResponse
+	14, // 44: interaction.v1.InteractionService.EditComment:output_type -> interaction.v1.EditCommentResponse
+	16, // 45: interaction.v1.InteractionService.DeleteComment:output_type -> interaction.v1.DeleteCommentResponse
+	18, // 46: interaction.v1.InteractionService.LikeComment:output_type -> interaction.v1.LikeCommentResponse
+	20, // 47: interaction.v1.InteractionService.DislikeComment:output_type -> interaction.v1.DislikeCommentResponse
+	22, // 48: interaction.v1.InteractionService.GetPostStats:output_type -> interaction.v1.GetPostStatsResponse
+	25, // 49: interaction.v1.InteractionService.ListPostComments:output_type -> interaction.v1.ListPostCommentsResponse
+	27, // 50: interaction.v1.InteractionService.MarkPostViewed:output_type -> interaction.v1.MarkPostViewedResponse
+	29, // 51: interaction.v1.InteractionService.RecordEngagement:output_type -> interaction.v1.RecordEngagementResponse
+	31, // 52: interaction.v1.InteractionService.BatchGetPostStats:output_type -> interaction.v1.BatchGetPostStatsResponse
+	33, // 53: interaction.v1.InteractionService.VotePoll:output_type -> interaction.v1.VotePollResponse
+	36, // 54: interaction.v1.InteractionService.GetPollResults:output_type -> interaction.v1.GetPollResultsResponse
+	38, // 55: interaction.v1.InteractionService.SavePost:output_type -> interaction.v1.SavePostResponse
+	40, // 56: interaction.v1.InteractionService.UnsavePost:output_type -> interaction.v1.UnsavePostResponse
+	43, // 57: interaction.v1.InteractionService.ListSavedPosts:output_type -> interaction.v1.ListSavedPostsResponse
+	39, // [39:58] is the sub-list for method output_type
+	20, // [20:39] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_proto_interaction_v1_interaction_proto_init() }
+func file_proto_interaction_v1_interaction_proto_init() {
+	if File_proto_interaction_v1_interaction_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_interaction_v1_interaction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DislikePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DislikePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveVoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveVoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactToPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactToPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -918,8 +3527,8 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_interaction_v1_interaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikePostResponse); i {
+		file_proto_interaction_v1_interaction_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -930,8 +3539,8 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_interaction_v1_interaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DislikePostRequest); i {
+		file_proto_interaction_v1_interaction_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -942,8 +3551,8 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_interaction_v1_interaction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DislikePostResponse); i {
+		file_proto_interaction_v1_interaction_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -954,8 +3563,8 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_interaction_v1_interaction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCommentRequest); i {
+		file_proto_interaction_v1_interaction_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -966,8 +3575,8 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_interaction_v1_interaction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCommentResponse); i {
+		file_proto_interaction_v1_interaction_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DislikeCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -978,7 +3587,19 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_interaction_v1_interaction_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_interaction_v1_interaction_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DislikeCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPostStatsRequest); i {
 			case 0:
 				return &v.state
@@ -990,7 +3611,7 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_interaction_v1_interaction_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_interaction_v1_interaction_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPostStatsResponse); i {
 			case 0:
 				return &v.state
@@ -1002,7 +3623,7 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_interaction_v1_interaction_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_interaction_v1_interaction_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListPostCommentsRequest); i {
 			case 0:
 				return &v.state
@@ -1014,7 +3635,7 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_interaction_v1_interaction_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_interaction_v1_interaction_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommentItem); i {
 			case 0:
 				return &v.state
@@ -1026,7 +3647,7 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_interaction_v1_interaction_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_interaction_v1_interaction_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListPostCommentsResponse); i {
 			case 0:
 				return &v.state
@@ -1038,7 +3659,7 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_interaction_v1_interaction_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_interaction_v1_interaction_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarkPostViewedRequest); i {
 			case 0:
 				return &v.state
@@ -1050,7 +3671,7 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_interaction_v1_interaction_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_interaction_v1_interaction_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarkPostViewedResponse); i {
 			case 0:
 				return &v.state
@@ -1062,19 +3683,212 @@ func file_proto_interaction_v1_interaction_proto_init() {
 				return nil
 			}
 		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordEngagementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordEngagementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetPostStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetPostStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotePollRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotePollResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPollResultsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollOptionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPollResultsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsavePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsavePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSavedPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavedPost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_interaction_v1_interaction_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSavedPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_interaction_v1_interaction_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      3,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_interaction_v1_interaction_proto_goTypes,
 		DependencyIndexes: file_proto_interaction_v1_interaction_proto_depIdxs,
+		EnumInfos:         file_proto_interaction_v1_interaction_proto_enumTypes,
 		MessageInfos:      file_proto_interaction_v1_interaction_proto_msgTypes,
 	}.Build()
 	File_proto_interaction_v1_interaction_proto = out.File
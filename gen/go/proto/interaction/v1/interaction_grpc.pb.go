@@ -24,10 +24,23 @@ const _ = grpc.SupportPackageIsVersion7
 type InteractionServiceClient interface {
 	LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error)
 	DislikePost(ctx context.Context, in *DislikePostRequest, opts ...grpc.CallOption) (*DislikePostResponse, error)
+	RemoveVote(ctx context.Context, in *RemoveVoteRequest, opts ...grpc.CallOption) (*RemoveVoteResponse, error)
+	ReactToPost(ctx context.Context, in *ReactToPostRequest, opts ...grpc.CallOption) (*ReactToPostResponse, error)
 	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*AddCommentResponse, error)
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*EditCommentResponse, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error)
+	DislikeComment(ctx context.Context, in *DislikeCommentRequest, opts ...grpc.CallOption) (*DislikeCommentResponse, error)
 	GetPostStats(ctx context.Context, in *GetPostStatsRequest, opts ...grpc.CallOption) (*GetPostStatsResponse, error)
 	ListPostComments(ctx context.Context, in *ListPostCommentsRequest, opts ...grpc.CallOption) (*ListPostCommentsResponse, error)
 	MarkPostViewed(ctx context.Context, in *MarkPostViewedRequest, opts ...grpc.CallOption) (*MarkPostViewedResponse, error)
+	RecordEngagement(ctx context.Context, in *RecordEngagementRequest, opts ...grpc.CallOption) (*RecordEngagementResponse, error)
+	BatchGetPostStats(ctx context.Context, in *BatchGetPostStatsRequest, opts ...grpc.CallOption) (*BatchGetPostStatsResponse, error)
+	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error)
+	GetPollResults(ctx context.Context, in *GetPollResultsRequest, opts ...grpc.CallOption) (*GetPollResultsResponse, error)
+	SavePost(ctx context.Context, in *SavePostRequest, opts ...grpc.CallOption) (*SavePostResponse, error)
+	UnsavePost(ctx context.Context, in *UnsavePostRequest, opts ...grpc.CallOption) (*UnsavePostResponse, error)
+	ListSavedPosts(ctx context.Context, in *ListSavedPostsRequest, opts ...grpc.CallOption) (*ListSavedPostsResponse, error)
 }
 
 type interactionServiceClient struct {
@@ -56,6 +69,24 @@ func (c *interactionServiceClient) DislikePost(ctx context.Context, in *DislikeP
 	return out, nil
 }
 
+func (c *interactionServiceClient) RemoveVote(ctx context.Context, in *RemoveVoteRequest, opts ...grpc.CallOption) (*RemoveVoteResponse, error) {
+	out := new(RemoveVoteResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/RemoveVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactionServiceClient) ReactToPost(ctx context.Context, in *ReactToPostRequest, opts ...grpc.CallOption) (*ReactToPostResponse, error) {
+	out := new(ReactToPostResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/ReactToPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *interactionServiceClient) AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*AddCommentResponse, error) {
 	out := new(AddCommentResponse)
 	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/AddComment", in, out, opts...)
@@ -65,6 +96,42 @@ func (c *interactionServiceClient) AddComment(ctx context.Context, in *AddCommen
 	return out, nil
 }
 
+func (c *interactionServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*EditCommentResponse, error) {
+	out := new(EditCommentResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/EditComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactionServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactionServiceClient) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error) {
+	out := new(LikeCommentResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/LikeComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactionServiceClient) DislikeComment(ctx context.Context, in *DislikeCommentRequest, opts ...grpc.CallOption) (*DislikeCommentResponse, error) {
+	out := new(DislikeCommentResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/DislikeComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *interactionServiceClient) GetPostStats(ctx context.Context, in *GetPostStatsRequest, opts ...grpc.CallOption) (*GetPostStatsResponse, error) {
 	out := new(GetPostStatsResponse)
 	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/GetPostStats", in, out, opts...)
@@ -92,16 +159,92 @@ func (c *interactionServiceClient) MarkPostViewed(ctx context.Context, in *MarkP
 	return out, nil
 }
 
+func (c *interactionServiceClient) RecordEngagement(ctx context.Context, in *RecordEngagementRequest, opts ...grpc.CallOption) (*RecordEngagementResponse, error) {
+	out := new(RecordEngagementResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/RecordEngagement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactionServiceClient) BatchGetPostStats(ctx context.Context, in *BatchGetPostStatsRequest, opts ...grpc.CallOption) (*BatchGetPostStatsResponse, error) {
+	out := new(BatchGetPostStatsResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/BatchGetPostStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactionServiceClient) VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error) {
+	out := new(VotePollResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/VotePoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactionServiceClient) GetPollResults(ctx context.Context, in *GetPollResultsRequest, opts ...grpc.CallOption) (*GetPollResultsResponse, error) {
+	out := new(GetPollResultsResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/GetPollResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactionServiceClient) SavePost(ctx context.Context, in *SavePostRequest, opts ...grpc.CallOption) (*SavePostResponse, error) {
+	out := new(SavePostResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/SavePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactionServiceClient) UnsavePost(ctx context.Context, in *UnsavePostRequest, opts ...grpc.CallOption) (*UnsavePostResponse, error) {
+	out := new(UnsavePostResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/UnsavePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactionServiceClient) ListSavedPosts(ctx context.Context, in *ListSavedPostsRequest, opts ...grpc.CallOption) (*ListSavedPostsResponse, error) {
+	out := new(ListSavedPostsResponse)
+	err := c.cc.Invoke(ctx, "/interaction.v1.InteractionService/ListSavedPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InteractionServiceServer is the server API for InteractionService service.
 // All implementations should embed UnimplementedInteractionServiceServer
 // for forward compatibility
 type InteractionServiceServer interface {
 	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
 	DislikePost(context.Context, *DislikePostRequest) (*DislikePostResponse, error)
+	RemoveVote(context.Context, *RemoveVoteRequest) (*RemoveVoteResponse, error)
+	ReactToPost(context.Context, *ReactToPostRequest) (*ReactToPostResponse, error)
 	AddComment(context.Context, *AddCommentRequest) (*AddCommentResponse, error)
+	EditComment(context.Context, *EditCommentRequest) (*EditCommentResponse, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error)
+	DislikeComment(context.Context, *DislikeCommentRequest) (*DislikeCommentResponse, error)
 	GetPostStats(context.Context, *GetPostStatsRequest) (*GetPostStatsResponse, error)
 	ListPostComments(context.Context, *ListPostCommentsRequest) (*ListPostCommentsResponse, error)
 	MarkPostViewed(context.Context, *MarkPostViewedRequest) (*MarkPostViewedResponse, error)
+	RecordEngagement(context.Context, *RecordEngagementRequest) (*RecordEngagementResponse, error)
+	BatchGetPostStats(context.Context, *BatchGetPostStatsRequest) (*BatchGetPostStatsResponse, error)
+	VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error)
+	GetPollResults(context.Context, *GetPollResultsRequest) (*GetPollResultsResponse, error)
+	SavePost(context.Context, *SavePostRequest) (*SavePostResponse, error)
+	UnsavePost(context.Context, *UnsavePostRequest) (*UnsavePostResponse, error)
+	ListSavedPosts(context.Context, *ListSavedPostsRequest) (*ListSavedPostsResponse, error)
 }
 
 // UnimplementedInteractionServiceServer should be embedded to have forward compatible implementations.
@@ -114,9 +257,27 @@ func (UnimplementedInteractionServiceServer) LikePost(context.Context, *LikePost
 func (UnimplementedInteractionServiceServer) DislikePost(context.Context, *DislikePostRequest) (*DislikePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DislikePost not implemented")
 }
+func (UnimplementedInteractionServiceServer) RemoveVote(context.Context, *RemoveVoteRequest) (*RemoveVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVote not implemented")
+}
+func (UnimplementedInteractionServiceServer) ReactToPost(context.Context, *ReactToPostRequest) (*ReactToPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactToPost not implemented")
+}
 func (UnimplementedInteractionServiceServer) AddComment(context.Context, *AddCommentRequest) (*AddCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
 }
+func (UnimplementedInteractionServiceServer) EditComment(context.Context, *EditCommentRequest) (*EditCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedInteractionServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedInteractionServiceServer) LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+}
+func (UnimplementedInteractionServiceServer) DislikeComment(context.Context, *DislikeCommentRequest) (*DislikeCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DislikeComment not implemented")
+}
 func (UnimplementedInteractionServiceServer) GetPostStats(context.Context, *GetPostStatsRequest) (*GetPostStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPostStats not implemented")
 }
@@ -126,6 +287,27 @@ func (UnimplementedInteractionServiceServer) ListPostComments(context.Context, *
 func (UnimplementedInteractionServiceServer) MarkPostViewed(context.Context, *MarkPostViewedRequest) (*MarkPostViewedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkPostViewed not implemented")
 }
+func (UnimplementedInteractionServiceServer) RecordEngagement(context.Context, *RecordEngagementRequest) (*RecordEngagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordEngagement not implemented")
+}
+func (UnimplementedInteractionServiceServer) BatchGetPostStats(context.Context, *BatchGetPostStatsRequest) (*BatchGetPostStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetPostStats not implemented")
+}
+func (UnimplementedInteractionServiceServer) VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (UnimplementedInteractionServiceServer) GetPollResults(context.Context, *GetPollResultsRequest) (*GetPollResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPollResults not implemented")
+}
+func (UnimplementedInteractionServiceServer) SavePost(context.Context, *SavePostRequest) (*SavePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePost not implemented")
+}
+func (UnimplementedInteractionServiceServer) UnsavePost(context.Context, *UnsavePostRequest) (*UnsavePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsavePost not implemented")
+}
+func (UnimplementedInteractionServiceServer) ListSavedPosts(context.Context, *ListSavedPostsRequest) (*ListSavedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedPosts not implemented")
+}
 
 // UnsafeInteractionServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to InteractionServiceServer will
@@ -174,6 +356,42 @@ func _InteractionService_DislikePost_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InteractionService_RemoveVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).RemoveVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/RemoveVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).RemoveVote(ctx, req.(*RemoveVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractionService_ReactToPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactToPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).ReactToPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/ReactToPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).ReactToPost(ctx, req.(*ReactToPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InteractionService_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddCommentRequest)
 	if err := dec(in); err != nil {
@@ -192,6 +410,78 @@ func _InteractionService_AddComment_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InteractionService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/EditComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractionService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractionService_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).LikeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/LikeComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).LikeComment(ctx, req.(*LikeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractionService_DislikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DislikeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).DislikeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/DislikeComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).DislikeComment(ctx, req.(*DislikeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InteractionService_GetPostStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPostStatsRequest)
 	if err := dec(in); err != nil {
@@ -246,6 +536,132 @@ func _InteractionService_MarkPostViewed_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InteractionService_RecordEngagement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordEngagementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).RecordEngagement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/RecordEngagement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).RecordEngagement(ctx, req.(*RecordEngagementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractionService_BatchGetPostStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetPostStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).BatchGetPostStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/BatchGetPostStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).BatchGetPostStats(ctx, req.(*BatchGetPostStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractionService_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).VotePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/VotePoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).VotePoll(ctx, req.(*VotePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractionService_GetPollResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPollResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).GetPollResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/GetPollResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).GetPollResults(ctx, req.(*GetPollResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractionService_SavePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).SavePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/SavePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).SavePost(ctx, req.(*SavePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractionService_UnsavePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsavePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).UnsavePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/UnsavePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).UnsavePost(ctx, req.(*UnsavePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractionService_ListSavedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractionServiceServer).ListSavedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interaction.v1.InteractionService/ListSavedPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractionServiceServer).ListSavedPosts(ctx, req.(*ListSavedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InteractionService_ServiceDesc is the grpc.ServiceDesc for InteractionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,10 +677,34 @@ var InteractionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DislikePost",
 			Handler:    _InteractionService_DislikePost_Handler,
 		},
+		{
+			MethodName: "RemoveVote",
+			Handler:    _InteractionService_RemoveVote_Handler,
+		},
+		{
+			MethodName: "ReactToPost",
+			Handler:    _InteractionService_ReactToPost_Handler,
+		},
 		{
 			MethodName: "AddComment",
 			Handler:    _InteractionService_AddComment_Handler,
 		},
+		{
+			MethodName: "EditComment",
+			Handler:    _InteractionService_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _InteractionService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "LikeComment",
+			Handler:    _InteractionService_LikeComment_Handler,
+		},
+		{
+			MethodName: "DislikeComment",
+			Handler:    _InteractionService_DislikeComment_Handler,
+		},
 		{
 			MethodName: "GetPostStats",
 			Handler:    _InteractionService_GetPostStats_Handler,
@@ -277,6 +717,34 @@ var InteractionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkPostViewed",
 			Handler:    _InteractionService_MarkPostViewed_Handler,
 		},
+		{
+			MethodName: "RecordEngagement",
+			Handler:    _InteractionService_RecordEngagement_Handler,
+		},
+		{
+			MethodName: "BatchGetPostStats",
+			Handler:    _InteractionService_BatchGetPostStats_Handler,
+		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _InteractionService_VotePoll_Handler,
+		},
+		{
+			MethodName: "GetPollResults",
+			Handler:    _InteractionService_GetPollResults_Handler,
+		},
+		{
+			MethodName: "SavePost",
+			Handler:    _InteractionService_SavePost_Handler,
+		},
+		{
+			MethodName: "UnsavePost",
+			Handler:    _InteractionService_UnsavePost_Handler,
+		},
+		{
+			MethodName: "ListSavedPosts",
+			Handler:    _InteractionService_ListSavedPosts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/interaction/v1/interaction.proto",
@@ -0,0 +1,65 @@
+package authz
+
+import (
+	"context"
+	"time"
+
+	userv1 "ghostnet/gen/go/proto/user/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Require проверяет право пользователя через user-service и возвращает готовую gRPC-ошибку:
+// PermissionDenied, если права нет, и Unavailable, если user-service не ответил.
+func Require(ctx context.Context, users userv1.UserServiceClient, userID int64, perm userv1.Permission) error {
+	resp, err := users.CheckPermission(ctx, &userv1.CheckPermissionRequest{UserId: userID, Permission: perm})
+	if err != nil {
+		return status.Error(codes.Unavailable, "failed to check permission")
+	}
+	if !resp.GetAllowed() {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// banActions называет действие в сообщении о бане; бан на всё сообщается без уточнения.
+var banActions = map[userv1.BanScope]string{
+	userv1.BanScope_BAN_SCOPE_POSTING:    "posting",
+	userv1.BanScope_BAN_SCOPE_COMMENTING: "commenting",
+}
+
+// CheckBan проверяет бан пользователя на действие из scope. Для обычного бана возвращает
+// PermissionDenied с userv1.BanDetails, по которым клиент описывает бан пользователю;
+// для теневого — shadow=true без ошибки.
+func CheckBan(ctx context.Context, users userv1.UserServiceClient, userID int64, scope userv1.BanScope) (bool, error) {
+	resp, err := users.CheckBan(ctx, &userv1.CheckBanRequest{UserId: userID, Scope: scope})
+	if err != nil {
+		return false, status.Error(codes.Unavailable, "failed to check ban")
+	}
+	if !resp.GetBanned() {
+		return resp.GetShadow(), nil
+	}
+
+	msg := "you are banned"
+	if action, ok := banActions[scope]; ok {
+		msg += " from " + action
+	}
+	if until, err := time.Parse(time.RFC3339, resp.GetExpiresAt()); err == nil {
+		msg += " until " + until.UTC().Format("02 Jan 2006 15:04 UTC")
+	} else {
+		msg += " permanently"
+	}
+	if resp.GetReason() != "" {
+		msg += ". Reason: " + resp.GetReason()
+	}
+	st, err := status.New(codes.PermissionDenied, msg).WithDetails(&userv1.BanDetails{
+		Scope:     scope,
+		Reason:    resp.GetReason(),
+		ExpiresAt: resp.GetExpiresAt(),
+	})
+	if err != nil {
+		return false, status.Error(codes.PermissionDenied, msg)
+	}
+	return false, st.Err()
+}
@@ -0,0 +1,244 @@
+package contentfilter
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Action — решение правила о тексте.
+type Action string
+
+const (
+	ActionAllow  Action = "allow" // правило только считает срабатывания
+	ActionFlag   Action = "flag"  // текст сохраняется, но помечается для модерации
+	ActionReject Action = "reject"
+)
+
+// maxRuleNameLength ограничивает имя правила: оно попадает в причину пометки "filter:<имя>" (до 32 символов).
+const maxRuleNameLength = 24
+
+// hits — счётчики срабатываний по ключу "<правило>:<действие>", доступны в /debug/vars.
+var hits = expvar.NewMap("contentfilter_hits")
+
+// Config — содержимое файла правил.
+type Config struct {
+	Rules []RuleConfig `json:"rules"`
+}
+
+// RuleConfig описывает одно правило; набор используемых полей зависит от Type.
+type RuleConfig struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"` // blocklist, links, caps, emoji, pii
+	Action Action `json:"action"`
+
+	Words    []string `json:"words,omitempty"`
+	Patterns []string `json:"patterns,omitempty"`
+
+	AllowedDomains []string `json:"allowed_domains,omitempty"`
+	BlockedDomains []string `json:"blocked_domains,omitempty"`
+
+	MaxRatio   float64 `json:"max_ratio,omitempty"`
+	MinLetters int     `json:"min_letters,omitempty"`
+	MaxCount   int     `json:"max_count,omitempty"`
+
+	Emails bool `json:"emails,omitempty"`
+	Phones bool `json:"phones,omitempty"`
+	Cards  bool `json:"cards,omitempty"`
+}
+
+var ruleBuilders = map[string]func(RuleConfig) (Rule, error){
+	"blocklist": newBlocklistRule,
+	"links":     newLinkRule,
+	"caps":      newCapsRule,
+	"emoji":     newEmojiRule,
+	"pii":       newPIIRule,
+}
+
+// Hit — сработавшее правило.
+type Hit struct {
+	Rule   string
+	Action Action
+	Detail string
+}
+
+// Result — итог проверки: самое строгое из действий сработавших правил.
+type Result struct {
+	Action Action
+	Hits   []Hit
+}
+
+// Rejected сообщает, что текст нельзя сохранять, и возвращает первое отклонившее правило.
+func (r Result) Rejected() (Hit, bool) {
+	for _, h := range r.Hits {
+		if h.Action == ActionReject {
+			return h, true
+		}
+	}
+	return Hit{}, false
+}
+
+// Flags возвращает правила, которые пометили текст для модерации.
+func (r Result) Flags() []Hit {
+	var out []Hit
+	for _, h := range r.Hits {
+		if h.Action == ActionFlag {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+type namedRule struct {
+	name   string
+	action Action
+	rule   Rule
+}
+
+// compile проверяет конфигурацию и собирает из неё конвейер.
+func compile(cfg Config) ([]namedRule, error) {
+	rules := make([]namedRule, 0, len(cfg.Rules))
+	seen := make(map[string]bool, len(cfg.Rules))
+	for i, rc := range cfg.Rules {
+		if rc.Name == "" {
+			return nil, fmt.Errorf("rule %d: name is required", i)
+		}
+		if len(rc.Name) > maxRuleNameLength {
+			return nil, fmt.Errorf("rule %q: name must not exceed %d characters", rc.Name, maxRuleNameLength)
+		}
+		if seen[rc.Name] {
+			return nil, fmt.Errorf("rule %q: duplicate name", rc.Name)
+		}
+		seen[rc.Name] = true
+		switch rc.Action {
+		case ActionAllow, ActionFlag, ActionReject:
+		default:
+			return nil, fmt.Errorf("rule %q: action must be allow, flag or reject", rc.Name)
+		}
+		build, ok := ruleBuilders[rc.Type]
+		if !ok {
+			return nil, fmt.Errorf("rule %q: unknown type %q", rc.Name, rc.Type)
+		}
+		rule, err := build(rc)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", rc.Name, err)
+		}
+		rules = append(rules, namedRule{name: rc.Name, action: rc.Action, rule: rule})
+	}
+	return rules, nil
+}
+
+// Filter — конвейер правил из файла, который перечитывается без перезапуска сервиса.
+// Нулевой *Filter пропускает любой текст.
+type Filter struct {
+	path   string
+	logger *zap.Logger
+
+	rules atomic.Pointer[[]namedRule]
+
+	mu      sync.Mutex
+	modTime time.Time
+}
+
+// New загружает правила из path. Ошибка в файле при старте фатальна, при перечитывании — нет.
+func New(path string, logger *zap.Logger) (*Filter, error) {
+	f := &Filter{path: path, logger: logger}
+	if err := f.Reload(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Reload перечитывает файл правил. При ошибке остаются прежние правила.
+func (f *Filter) Reload() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return fmt.Errorf("stat filter config: %w", err)
+	}
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return fmt.Errorf("read filter config: %w", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("parse filter config: %w", err)
+	}
+	rules, err := compile(cfg)
+	if err != nil {
+		return err
+	}
+
+	f.rules.Store(&rules)
+	f.modTime = info.ModTime()
+	return nil
+}
+
+// Watch проверяет файл правил раз в interval и перечитывает его при изменении.
+func (f *Filter) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(f.path)
+		if err != nil {
+			f.logger.Warn("content filter config unavailable", zap.Error(err))
+			continue
+		}
+		f.mu.Lock()
+		changed := !info.ModTime().Equal(f.modTime)
+		f.mu.Unlock()
+		if !changed {
+			continue
+		}
+
+		if err := f.Reload(); err != nil {
+			f.logger.Error("failed to reload content filter, keeping previous rules", zap.Error(err))
+			continue
+		}
+		f.logger.Info("content filter reloaded", zap.String("path", f.path))
+	}
+}
+
+// Check прогоняет текст через все правила и считает срабатывания.
+func (f *Filter) Check(text string) Result {
+	res := Result{Action: ActionAllow}
+	if f == nil || text == "" {
+		return res
+	}
+	rules := f.rules.Load()
+	if rules == nil {
+		return res
+	}
+
+	for _, r := range *rules {
+		detail, hit := r.rule.Check(text)
+		if !hit {
+			continue
+		}
+		hits.Add(r.name+":"+string(r.action), 1)
+		res.Hits = append(res.Hits, Hit{Rule: r.name, Action: r.action, Detail: detail})
+		switch {
+		case r.action == ActionReject:
+			res.Action = ActionReject
+		case r.action == ActionFlag && res.Action == ActionAllow:
+			res.Action = ActionFlag
+		}
+	}
+	return res
+}
@@ -0,0 +1,270 @@
+package contentfilter
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Rule — одна проверка конвейера. Check возвращает текстовое пояснение, если правило сработало.
+type Rule interface {
+	Check(text string) (string, bool)
+}
+
+// blocklistRule ищет запрещённые слова (целиком, без учёта регистра) и регулярные выражения.
+type blocklistRule struct {
+	words    map[string]struct{}
+	patterns []*regexp.Regexp
+}
+
+func newBlocklistRule(c RuleConfig) (Rule, error) {
+	r := &blocklistRule{words: make(map[string]struct{}, len(c.Words))}
+	for _, w := range c.Words {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			r.words[w] = struct{}{}
+		}
+	}
+	for _, p := range c.Patterns {
+		re, err := regexp.Compile("(?i)" + p)
+		if err != nil {
+			return nil, fmt.Errorf("pattern %q: %w", p, err)
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	return r, nil
+}
+
+func (r *blocklistRule) Check(text string) (string, bool) {
+	for _, w := range strings.FieldsFunc(strings.ToLower(text), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	}) {
+		if _, ok := r.words[w]; ok {
+			return "blocked word", true
+		}
+	}
+	for _, re := range r.patterns {
+		if re.MatchString(text) {
+			return "blocked pattern", true
+		}
+	}
+	return "", false
+}
+
+var linkRe = regexp.MustCompile(`(?i)\b((?:https?://)?(?:[a-z0-9-]+\.)+[a-z]{2,}(?:/\S*)?)`)
+
+// linkRule проверяет домены ссылок. Если задан список разрешённых, любой другой домен нарушает
+// правило; иначе нарушением считается домен из списка запрещённых. Поддомены наследуют правило.
+type linkRule struct {
+	allowed []string
+	blocked []string
+}
+
+func newLinkRule(c RuleConfig) (Rule, error) {
+	return &linkRule{allowed: normalizeDomains(c.AllowedDomains), blocked: normalizeDomains(c.BlockedDomains)}, nil
+}
+
+func normalizeDomains(domains []string) []string {
+	out := make([]string, 0, len(domains))
+	for _, d := range domains {
+		if d = strings.ToLower(strings.TrimSpace(d)); d != "" {
+			out = append(out, strings.TrimPrefix(d, "www."))
+		}
+	}
+	return out
+}
+
+func (r *linkRule) Check(text string) (string, bool) {
+	for _, link := range linkRe.FindAllString(text, -1) {
+		if !strings.Contains(link, "://") {
+			link = "http://" + link
+		}
+		u, err := url.Parse(link)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+		if len(r.allowed) > 0 && !matchDomain(host, r.allowed) {
+			return "link to " + host + " is not allowed", true
+		}
+		if matchDomain(host, r.blocked) {
+			return "link to " + host + " is blocked", true
+		}
+	}
+	return "", false
+}
+
+func matchDomain(host string, domains []string) bool {
+	for _, d := range domains {
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// capsRule срабатывает, когда доля заглавных среди букв выше MaxRatio. Короткие тексты не проверяются.
+type capsRule struct {
+	maxRatio   float64
+	minLetters int
+}
+
+func newCapsRule(c RuleConfig) (Rule, error) {
+	if c.MaxRatio <= 0 || c.MaxRatio > 1 {
+		return nil, fmt.Errorf("max_ratio must be in (0, 1]")
+	}
+	return &capsRule{maxRatio: c.MaxRatio, minLetters: c.MinLetters}, nil
+}
+
+func (r *capsRule) Check(text string) (string, bool) {
+	letters, upper := 0, 0
+	for _, c := range text {
+		if unicode.IsLetter(c) {
+			letters++
+			if unicode.IsUpper(c) {
+				upper++
+			}
+		}
+	}
+	if letters == 0 || letters < r.minLetters {
+		return "", false
+	}
+	if float64(upper)/float64(letters) > r.maxRatio {
+		return "too many capital letters", true
+	}
+	return "", false
+}
+
+// emojiRule ограничивает число эмодзи в тексте.
+type emojiRule struct {
+	maxCount int
+}
+
+func newEmojiRule(c RuleConfig) (Rule, error) {
+	if c.MaxCount <= 0 {
+		return nil, fmt.Errorf("max_count must be positive")
+	}
+	return &emojiRule{maxCount: c.MaxCount}, nil
+}
+
+func (r *emojiRule) Check(text string) (string, bool) {
+	n := 0
+	for _, c := range text {
+		if isEmoji(c) {
+			n++
+		}
+	}
+	if n > r.maxCount {
+		return fmt.Sprintf("too many emoji (%d)", n), true
+	}
+	return "", false
+}
+
+func isEmoji(c rune) bool {
+	return (c >= 0x1F300 && c <= 0x1FAFF) || (c >= 0x2600 && c <= 0x27BF) || (c >= 0x1F1E6 && c <= 0x1F1FF)
+}
+
+var (
+	emailRe = regexp.MustCompile(`(?i)[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}`)
+	// телефон — либо международный номер с «+», либо российский (8/7 или код в скобках)
+	// с группами 3-3-2-2; разделители только пробел, дефис и скобки, чтобы даты, время,
+	// суммы с разрядами и ISBN не принимались за номер
+	phoneRe = regexp.MustCompile(`\+\d[\d ()-]{8,}\d|[78][ -]?\(?\d{3}\)?[ -]?\d{3}[ -]?\d{2}[ -]?\d{2}|\(\d{3}\) ?\d{3}[ -]?\d{2}[ -]?\d{2}`)
+	cardRe  = regexp.MustCompile(`\b(?:\d[ -]?){13,19}\b`)
+)
+
+// piiRule ищет личные данные: адреса почты, телефоны и номера банковских карт.
+type piiRule struct {
+	emails, phones, cards bool
+}
+
+func newPIIRule(c RuleConfig) (Rule, error) {
+	r := &piiRule{emails: c.Emails, phones: c.Phones, cards: c.Cards}
+	if !r.emails && !r.phones && !r.cards {
+		return nil, fmt.Errorf("at least one of emails, phones, cards must be enabled")
+	}
+	return r, nil
+}
+
+func (r *piiRule) Check(text string) (string, bool) {
+	if r.emails && emailRe.MatchString(text) {
+		return "email address", true
+	}
+	// номер карты проверяется раньше телефона: иначе его поймает более общий шаблон
+	if r.cards {
+		for _, m := range cardRe.FindAllString(text, -1) {
+			if luhnValid(m) {
+				return "card number", true
+			}
+		}
+	}
+	if r.phones {
+		for _, loc := range phoneRe.FindAllStringIndex(text, -1) {
+			if !isolatedNumber(text, loc[0], loc[1]) {
+				continue
+			}
+			if digits := countDigits(text[loc[0]:loc[1]]); digits >= 10 && digits <= 15 {
+				return "phone number", true
+			}
+		}
+	}
+	return "", false
+}
+
+// isolatedNumber сообщает, что text[start:end] не продолжает соседнее число:
+// рядом нет цифры, а точка или двоеточие не склеивают его с цифрами, как в «18.10.2026 15:00».
+func isolatedNumber(text string, start, end int) bool {
+	if start > 0 {
+		c := text[start-1]
+		if isDigit(c) {
+			return false
+		}
+		if (c == '.' || c == ':') && start > 1 && isDigit(text[start-2]) {
+			return false
+		}
+	}
+	if end < len(text) {
+		c := text[end]
+		if isDigit(c) {
+			return false
+		}
+		if (c == '.' || c == ':') && end+1 < len(text) && isDigit(text[end+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func countDigits(s string) int {
+	n := 0
+	for _, c := range s {
+		if c >= '0' && c <= '9' {
+			n++
+		}
+	}
+	return n
+}
+
+func luhnValid(s string) bool {
+	sum, n := 0, 0
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if n%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		n++
+	}
+	return n >= 13 && sum%10 == 0
+}
@@ -0,0 +1,79 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCursor возвращается, когда токен не удалось разобрать.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor описывает позицию keyset-пагинации по паре (created_at, id).
+type Cursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// Encode упаковывает позицию в непрозрачный токен для клиента.
+func Encode(c Cursor) string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixMicro(), 10) + ":" + strconv.FormatInt(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Decode разбирает токен. Пустой токен означает первую страницу и даёт nil.
+func Decode(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	tsPart, idPart, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+	micros, err := strconv.ParseInt(tsPart, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil || id <= 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &Cursor{CreatedAt: time.UnixMicro(micros).UTC(), ID: id}, nil
+}
+
+const offsetPrefix = "o:"
+
+// EncodeOffset упаковывает смещение для выдач, упорядоченных не по (created_at, id),
+// например поиска по релевантности.
+func EncodeOffset(offset int32) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(offsetPrefix + strconv.FormatInt(int64(offset), 10)))
+}
+
+// DecodeOffset разбирает токен EncodeOffset. Пустой токен означает нулевое смещение.
+func DecodeOffset(token string) (int32, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	value, ok := strings.CutPrefix(string(raw), offsetPrefix)
+	if !ok {
+		return 0, ErrInvalidCursor
+	}
+	offset, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || offset < 0 {
+		return 0, ErrInvalidCursor
+	}
+	return int32(offset), nil
+}
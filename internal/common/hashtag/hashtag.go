@@ -0,0 +1,65 @@
+package hashtag
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MaxTagLength ограничивает длину одного тега в рунах.
+	MaxTagLength = 64
+	// MaxTagsPerPost ограничивает число тегов, сохраняемых для одного поста.
+	MaxTagsPerPost = 10
+)
+
+// хэштег должен начинаться после пробела/пунктуации, чтобы не ловить якоря вроде "a#b" и "&#39;".
+var hashtagRe = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&#])#([\p{L}\p{N}_]+)`)
+
+// Normalize приводит тег к каноничному виду: без ведущего '#', в нижнем регистре.
+// Возвращает пустую строку, если тег невалиден.
+func Normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if tag == "" || utf8.RuneCountInString(tag) > MaxTagLength {
+		return ""
+	}
+
+	hasLetter := false
+	for _, r := range tag {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r) || r == '_':
+		default:
+			return ""
+		}
+	}
+	// "#1" и "#2024" — это нумерация, а не тема
+	if !hasLetter {
+		return ""
+	}
+	return tag
+}
+
+// Extract возвращает уникальные нормализованные хэштеги текста в порядке появления.
+func Extract(text string) []string {
+	matches := hashtagRe.FindAllStringSubmatch(text, -1)
+	tags := make([]string, 0, len(matches))
+	seen := make(map[string]struct{}, len(matches))
+	for _, m := range matches {
+		tag := Normalize(m[1])
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		tags = append(tags, tag)
+		if len(tags) == MaxTagsPerPost {
+			break
+		}
+	}
+	return tags
+}
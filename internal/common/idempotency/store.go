@@ -0,0 +1,252 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// MaxKeyLength — ограничение длины ключа от клиента.
+const MaxKeyLength = 128
+
+// pendingLease — сколько держится ключ, пока запрос выполняется. Полный TTL ключ получает вместе
+// с ответом, так что после падения процесса посреди запроса ключ освободится через минуту, а не через TTL.
+const pendingLease = time.Minute
+
+// idempotency_keys общая для post-service и interaction-service: обе создают её при старте.
+// scope — полное имя gRPC-метода; response пуст, пока запрос с ключом выполняется,
+// и всё это время expires_at отстоит от начала запроса на pendingLease.
+const createKeysTable = `
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    scope        VARCHAR(128) NOT NULL,
+    user_id      BIGINT NOT NULL,
+    key          VARCHAR(128) NOT NULL,
+    request_hash BYTEA NOT NULL,
+    response     BYTEA,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (scope, user_id, key)
+);`
+
+const createKeysExpiresIndex = `
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires ON idempotency_keys (expires_at);`
+
+// RunMigrations создаёт таблицу ключей.
+func RunMigrations(ctx context.Context, pool *pgxpool.Pool) error {
+	for _, stmt := range []string{createKeysTable, createKeysExpiresIndex} {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("apply migration: %w", err)
+		}
+	}
+	return nil
+}
+
+// Store запоминает ответы на запросы с ключом идемпотентности в Postgres.
+type Store struct {
+	pool   *pgxpool.Pool
+	ttl    time.Duration
+	logger *zap.Logger
+}
+
+// NewStore создаёт хранилище; ключ перестаёт действовать через ttl после первого запроса.
+func NewStore(pool *pgxpool.Pool, ttl time.Duration, logger *zap.Logger) *Store {
+	return &Store{pool: pool, ttl: ttl, logger: logger}
+}
+
+// UnaryServerInterceptor делает идемпотентными все методы, чей запрос содержит idempotency_key.
+// Ключ действует в пределах метода и пользователя из user_id или author_user_id запроса.
+// Повтор с тем же ключом и теми же данными получает сохранённый ответ, с другими данными —
+// AlreadyExists, а пока первый запрос не завершился — Aborted. Ошибка обработчика ключ
+// освобождает, чтобы клиент мог повторить запрос.
+//
+// Ответ сохраняется после обработчика, а не в его транзакции: если процесс упадёт между ними,
+// повторы будут получать Aborted, пока не истечёт pendingLease.
+func (s *Store) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		keyed, ok := req.(keyedRequest)
+		if !ok || keyed.GetIdempotencyKey() == "" {
+			return handler(ctx, req)
+		}
+		return s.do(ctx, info.FullMethod, requestUserID(req), keyed, func() (any, error) {
+			return handler(ctx, req)
+		})
+	}
+}
+
+type keyedRequest interface {
+	proto.Message
+	GetIdempotencyKey() string
+}
+
+func requestUserID(req any) int64 {
+	switch r := req.(type) {
+	case interface{ GetUserId() int64 }:
+		return r.GetUserId()
+	case interface{ GetAuthorUserId() int64 }:
+		return r.GetAuthorUserId()
+	}
+	return 0
+}
+
+func (s *Store) do(ctx context.Context, scope string, userID int64, req keyedRequest, handle func() (any, error)) (any, error) {
+	key := req.GetIdempotencyKey()
+	if len(key) > MaxKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency_key is longer than %d characters", MaxKeyLength)
+	}
+
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to hash request")
+	}
+	sum := sha256.Sum256(raw)
+	hash := sum[:]
+
+	reserved, err := s.reserve(ctx, scope, userID, key, hash)
+	if err != nil {
+		s.logger.Error("failed to reserve idempotency key", zap.String("scope", scope), zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "idempotency store unavailable")
+	}
+	if !reserved {
+		return s.replay(ctx, scope, userID, key, hash)
+	}
+
+	resp, err := handle()
+	// клиент, повторяющий запрос после таймаута, уже отменил контекст, но ключ всё равно
+	// нужно освободить или завершить — иначе повтор не получит ни ответа, ни права выполнить запрос
+	saveCtx := context.WithoutCancel(ctx)
+	if err != nil {
+		if relErr := s.release(saveCtx, scope, userID, key); relErr != nil {
+			s.logger.Error("failed to release idempotency key", zap.String("scope", scope), zap.Error(relErr))
+		}
+		return resp, err
+	}
+
+	if msg, ok := resp.(proto.Message); ok {
+		if err := s.complete(saveCtx, scope, userID, key, msg); err != nil {
+			// запрос уже выполнен, поэтому ответ отдаём, а повтор получит Aborted до истечения аренды
+			s.logger.Error("failed to store idempotent response", zap.String("scope", scope), zap.Error(err))
+		}
+	}
+	return resp, nil
+}
+
+func (s *Store) replay(ctx context.Context, scope string, userID int64, key string, hash []byte) (proto.Message, error) {
+	var storedHash, response []byte
+	err := s.pool.QueryRow(ctx, `
+		SELECT request_hash, response FROM idempotency_keys
+		WHERE scope = $1 AND user_id = $2 AND key = $3
+	`, scope, userID, key).Scan(&storedHash, &response)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// первый запрос завершился ошибкой и освободил ключ
+		return nil, status.Error(codes.Aborted, "request with this idempotency key failed, retry")
+	}
+	if err != nil {
+		s.logger.Error("failed to load idempotency key", zap.String("scope", scope), zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "idempotency store unavailable")
+	}
+
+	if !bytes.Equal(storedHash, hash) {
+		return nil, status.Error(codes.AlreadyExists, "idempotency key was already used with a different request")
+	}
+	if response == nil {
+		return nil, status.Error(codes.Aborted, "request with this idempotency key is still in progress")
+	}
+
+	var stored anypb.Any
+	if err := proto.Unmarshal(response, &stored); err != nil {
+		s.logger.Error("failed to decode idempotent response", zap.String("scope", scope), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to decode stored response")
+	}
+	resp, err := stored.UnmarshalNew()
+	if err != nil {
+		s.logger.Error("failed to decode idempotent response", zap.String("scope", scope), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to decode stored response")
+	}
+	return resp, nil
+}
+
+// reserve занимает ключ на pendingLease; просроченный ключ занимается заново.
+func (s *Store) reserve(ctx context.Context, scope string, userID int64, key string, hash []byte) (bool, error) {
+	var reserved bool
+	err := s.pool.QueryRow(ctx, `
+		INSERT INTO idempotency_keys (scope, user_id, key, request_hash, expires_at)
+		VALUES ($1, $2, $3, $4, NOW() + make_interval(secs => $5))
+		ON CONFLICT (scope, user_id, key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+		    response     = NULL,
+		    created_at   = NOW(),
+		    expires_at   = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= NOW()
+		RETURNING TRUE
+	`, scope, userID, key, hash, pendingLease.Seconds()).Scan(&reserved)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("reserve key: %w", err)
+	}
+	return reserved, nil
+}
+
+func (s *Store) complete(ctx context.Context, scope string, userID int64, key string, resp proto.Message) error {
+	stored, err := anypb.New(resp)
+	if err != nil {
+		return fmt.Errorf("wrap response: %w", err)
+	}
+	raw, err := proto.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("marshal response: %w", err)
+	}
+	if _, err := s.pool.Exec(ctx, `
+		UPDATE idempotency_keys
+		SET response = $4, expires_at = created_at + make_interval(secs => $5)
+		WHERE scope = $1 AND user_id = $2 AND key = $3
+	`, scope, userID, key, raw, s.ttl.Seconds()); err != nil {
+		return fmt.Errorf("store response: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) release(ctx context.Context, scope string, userID int64, key string) error {
+	if _, err := s.pool.Exec(ctx, `
+		DELETE FROM idempotency_keys WHERE scope = $1 AND user_id = $2 AND key = $3 AND response IS NULL
+	`, scope, userID, key); err != nil {
+		return fmt.Errorf("release key: %w", err)
+	}
+	return nil
+}
+
+// RunCleanup раз в interval удаляет просроченные ключи, пока контекст не завершится.
+func (s *Store) RunCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		tag, err := s.pool.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= NOW()`)
+		if err != nil {
+			s.logger.Error("failed to delete expired idempotency keys", zap.Error(err))
+			continue
+		}
+		if n := tag.RowsAffected(); n > 0 {
+			s.logger.Info("expired idempotency keys deleted", zap.Int64("count", n))
+		}
+	}
+}
@@ -9,13 +9,13 @@ import (
 )
 
 // RunGRPC поднимает gRPC-сервер и блокируется до остановки.
-func RunGRPC(logger *zap.Logger, port string, register func(*grpc.Server)) error {
+func RunGRPC(logger *zap.Logger, port string, register func(*grpc.Server), opts ...grpc.ServerOption) error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
 	if err != nil {
 		return fmt.Errorf("listen: %w", err)
 	}
 
-	srv := grpc.NewServer()
+	srv := grpc.NewServer(opts...)
 	if register != nil {
 		register(srv)
 	}
@@ -0,0 +1,19 @@
+package server
+
+import (
+	"expvar"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// RunMetrics отдаёт счётчики expvar по /debug/vars. Ошибки только логируются: метрики не должны ронять сервис.
+func RunMetrics(logger *zap.Logger, addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	logger.Info("metrics server started", zap.String("addr", addr))
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		logger.Error("metrics server failed", zap.Error(err))
+	}
+}
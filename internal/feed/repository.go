@@ -18,17 +18,40 @@ func NewRepository(pool *pgxpool.Pool) *Repository {
 }
 
 // NextPost возвращает следующий пост для пользователя или false, если постов нет.
-func (r *Repository) NextPost(ctx context.Context, userID int64) (postID int64, authorID int64, found bool, err error) {
+// Оригинал и все его репосты считаются одним постом: если пользователь видел любой из них,
+// остальные пропускаются. Репосты собственных постов тоже пропускаются. Непустой tag оставляет в выдаче только посты с этим хэштегом.
+func (r *Repository) NextPost(ctx context.Context, userID int64, tag string) (postID int64, authorID int64, found bool, err error) {
 	err = r.pool.QueryRow(ctx, `
 		SELECT p.id, p.author_user_id
 		FROM posts p
 		LEFT JOIN post_views v ON v.post_id = p.id AND v.user_id = $1
+		LEFT JOIN posts o ON o.id = p.repost_of_post_id
 		WHERE p.author_user_id <> $1
 		  AND p.is_deleted = FALSE
 		  AND v.id IS NULL
+		  AND (p.repost_of_post_id IS NULL OR (
+		      o.is_deleted = FALSE
+		      AND o.author_user_id <> $1
+		  ))
+		  AND NOT EXISTS (
+		      SELECT 1
+		      FROM post_views sv
+		      JOIN posts sp ON sp.id = sv.post_id
+		      WHERE sv.user_id = $1
+		        AND COALESCE(sp.repost_of_post_id, sp.id) = COALESCE(p.repost_of_post_id, p.id)
+		  )
+		  AND ($2 = '' OR EXISTS (
+		      SELECT 1 FROM post_tags t WHERE t.post_id = p.id AND t.tag = $2
+		  ))
+		  AND NOT EXISTS (
+		      SELECT 1 FROM user_bans b
+		      WHERE b.user_id = p.author_user_id AND b.shadow AND b.revoked_at IS NULL
+		        AND (b.expires_at IS NULL OR b.expires_at > NOW())
+		        AND b.scope IN ('posting', 'all')
+		  )
 		ORDER BY p.created_at DESC
 		LIMIT 1
-	`, userID).Scan(&postID, &authorID)
+	`, userID, tag).Scan(&postID, &authorID)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return 0, 0, false, nil
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	feedv1 "ghostnet/gen/go/proto/feed/v1"
+	"ghostnet/internal/common/hashtag"
+
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -29,7 +31,15 @@ func (s *Service) GetNextPostForUser(ctx context.Context, req *feedv1.GetNextPos
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
 
-	postID, authorID, found, err := s.repo.NextPost(ctx, req.GetUserId())
+	var tag string
+	if req.GetTag() != "" {
+		tag = hashtag.Normalize(req.GetTag())
+		if tag == "" {
+			return nil, status.Error(codes.InvalidArgument, "invalid tag")
+		}
+	}
+
+	postID, authorID, found, err := s.repo.NextPost(ctx, req.GetUserId(), tag)
 	if err != nil {
 		s.logger.Error("failed to get next post", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to get next post")
@@ -0,0 +1,82 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	interactionv1 "ghostnet/gen/go/proto/interaction/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	commentsPageSize      = 10
+	commentPreviewLength  = 300
+	maxCommentsReplyRunes = 4000
+)
+
+// handleComments выполняет /comments <post_id>: показывает ветки комментариев с псевдонимами авторов.
+func (h *Handler) handleComments(ctx context.Context, chatID, userID int64, args string) {
+	postID, err := strconv.ParseInt(strings.TrimSpace(args), 10, 64)
+	if err != nil || postID <= 0 {
+		h.reply(ctx, chatID, "Использование: /comments <id поста>")
+		return
+	}
+	h.touchView(ctx, userID, postID)
+	h.engagement.markComments(userID, postID)
+
+	resp, err := h.interactionClient.ListPostComments(ctx, &interactionv1.ListPostCommentsRequest{
+		UserId:   userID,
+		PostId:   postID,
+		PageSize: commentsPageSize,
+		Layout:   interactionv1.CommentLayout_COMMENT_LAYOUT_TREE,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			h.reply(ctx, chatID, "Пост не найден.")
+			return
+		}
+		h.logger.Warn("failed to list comments", zap.Error(err))
+		h.reply(ctx, chatID, "Не удалось загрузить комментарии, попробуйте позже.")
+		return
+	}
+	if len(resp.GetComments()) == 0 {
+		h.reply(ctx, chatID, "💬 Комментариев пока нет.")
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "💬 Комментарии к посту #%d:\n", postID)
+	for _, c := range resp.GetComments() {
+		b.WriteString("\n")
+		renderComment(&b, c)
+	}
+	h.reply(ctx, chatID, truncateRunes(b.String(), maxCommentsReplyRunes))
+}
+
+// renderComment пишет комментарий и ответы на него, сдвигая ответы по глубине.
+func renderComment(b *strings.Builder, c *interactionv1.CommentItem) {
+	indent := strings.Repeat("    ", int(c.GetDepth()))
+	if c.GetDepth() > 0 {
+		indent += "↳ "
+	}
+	if c.GetDeleted() {
+		fmt.Fprintf(b, "%s[комментарий удалён]\n", indent)
+	} else {
+		meta := ""
+		if c.GetScore() != 0 {
+			meta += fmt.Sprintf(" [%+d]", c.GetScore())
+		}
+		if c.GetEditedAt() != "" {
+			meta += " (изм.)"
+		}
+		fmt.Fprintf(b, "%s%s%s: %s\n", indent, c.GetAuthorPseudonym(), meta, truncateRunes(c.GetText(), commentPreviewLength))
+	}
+	for _, reply := range c.GetReplies() {
+		renderComment(b, reply)
+	}
+}
@@ -0,0 +1,312 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	postv1 "ghostnet/gen/go/proto/post/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	composerIdleTimeout   = 10 * time.Minute
+	composerSweepInterval = time.Minute
+	draftsListLimit       = 10
+)
+
+// composeSession — пост, который пользователь набирает в боте.
+type composeSession struct {
+	userID     int64
+	chatID     int64
+	draft      *postv1.Draft
+	hasContent bool
+	updatedAt  time.Time
+}
+
+// composerStore хранит открытые редакторы в памяти по chat id.
+type composerStore struct {
+	mu       sync.Mutex
+	sessions map[int64]*composeSession
+}
+
+func newComposerStore() *composerStore {
+	return &composerStore{sessions: make(map[int64]*composeSession)}
+}
+
+func (c *composerStore) start(chatID, userID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessions[chatID] = &composeSession{userID: userID, chatID: chatID, draft: &postv1.Draft{}, updatedAt: time.Now()}
+}
+
+func (c *composerStore) put(sess *composeSession) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sess.updatedAt = time.Now()
+	c.sessions[sess.chatID] = sess
+}
+
+func (c *composerStore) take(chatID int64) (*composeSession, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sess, ok := c.sessions[chatID]
+	delete(c.sessions, chatID)
+	return sess, ok
+}
+
+// takeIdle забирает сессии, в которых давно не было активности.
+func (c *composerStore) takeIdle(now time.Time) []*composeSession {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var idle []*composeSession
+	for chatID, sess := range c.sessions {
+		if now.Sub(sess.updatedAt) >= composerIdleTimeout {
+			idle = append(idle, sess)
+			delete(c.sessions, chatID)
+		}
+	}
+	return idle
+}
+
+func (h *Handler) handleNewPost(ctx context.Context, chatID, userID int64) {
+	h.composer.start(chatID, userID)
+	h.reply(ctx, chatID, "✍️ Отправьте текст поста или фото/видео с подписью.\n/publish — опубликовать, /cancel — отменить.")
+}
+
+// handleComposeInput записывает сообщение в открытый редактор. Следующее сообщение заменяет предыдущее.
+func (h *Handler) handleComposeInput(ctx context.Context, chatID int64, msg *TelegramMessage) {
+	h.composer.mu.Lock()
+	sess, ok := h.composer.sessions[chatID]
+	if ok {
+		d := sess.draft
+		d.MediaType, d.TelegramFileId, d.TelegramUniqueId = "", "", ""
+		d.Text, d.Entities = msg.Text, toProtoEntities(msg.Entities)
+		switch {
+		case len(msg.Photo) > 0:
+			largest := msg.Photo[len(msg.Photo)-1]
+			d.MediaType, d.TelegramFileId, d.TelegramUniqueId = "photo", largest.FileID, largest.FileUniqueID
+			d.Text, d.Entities = msg.Caption, toProtoEntities(msg.CaptionEntities)
+		case msg.Video != nil:
+			d.MediaType, d.TelegramFileId, d.TelegramUniqueId = "video", msg.Video.FileID, msg.Video.FileUniqueID
+			d.Text, d.Entities = msg.Caption, toProtoEntities(msg.CaptionEntities)
+		}
+		sess.hasContent = true
+		sess.updatedAt = time.Now()
+	}
+	h.composer.mu.Unlock()
+
+	if ok {
+		h.reply(ctx, chatID, "Готово. /publish — опубликовать, /cancel — отменить.")
+	}
+}
+
+func (h *Handler) handlePublish(ctx context.Context, chatID, userID int64) {
+	// сессия забирается на время публикации, чтобы параллельный ввод её не менял
+	sess, ok := h.composer.take(chatID)
+	if !ok || !sess.hasContent {
+		if ok {
+			h.composer.put(sess)
+		}
+		h.reply(ctx, chatID, "Нечего публиковать. /new — написать пост.")
+		return
+	}
+
+	draftID, err := h.saveDraft(ctx, sess)
+	if err != nil {
+		h.composer.put(sess)
+		h.reply(ctx, chatID, "Не удалось сохранить пост, попробуйте позже.")
+		return
+	}
+
+	resp, err := h.postClient.PublishDraft(ctx, &postv1.PublishDraftRequest{UserId: userID, DraftId: draftID, IdempotencyKey: idempotencyKey(ctx, "publish-draft", draftID)})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			// редактор остаётся открытым, чтобы пользователь мог исправить пост
+			h.composer.put(sess)
+			h.reply(ctx, chatID, "Пост не прошёл проверку: "+status.Convert(err).Message())
+			return
+		case codes.AlreadyExists:
+			h.composer.put(sess)
+			h.reply(ctx, chatID, "Такой пост уже недавно публиковался. Измените текст или /cancel.")
+			return
+		case codes.PermissionDenied:
+			h.reply(ctx, chatID, "⛔ "+banText(err)+"\nПост сохранён в /drafts.")
+			return
+		}
+		h.logger.Warn("failed to publish draft", zap.Error(err))
+		h.reply(ctx, chatID, "Не удалось опубликовать пост, он сохранён в /drafts.")
+		return
+	}
+
+	h.reply(ctx, chatID, publishedText(resp))
+}
+
+// publishedText подтверждает публикацию и предупреждает, если пост повторяет недавний.
+func publishedText(resp *postv1.PublishDraftResponse) string {
+	text := fmt.Sprintf("✅ Пост #%d опубликован анонимно.", resp.GetPostId())
+	switch resp.GetDuplicateKind() {
+	case "media":
+		text += fmt.Sprintf("\n⚠️ Это фото или видео уже было в посте #%d.", resp.GetDuplicateOfPostId())
+	case "text":
+		text += fmt.Sprintf("\n⚠️ Такой же текст уже был в посте #%d.", resp.GetDuplicateOfPostId())
+	case "near_text":
+		text += fmt.Sprintf("\n⚠️ Очень похожий пост уже был: #%d.", resp.GetDuplicateOfPostId())
+	}
+	return text
+}
+
+func (h *Handler) handleCancel(ctx context.Context, chatID int64) {
+	if _, ok := h.composer.take(chatID); !ok {
+		h.reply(ctx, chatID, "Сейчас нечего отменять.")
+		return
+	}
+	h.reply(ctx, chatID, "Редактирование отменено.")
+}
+
+// leaveComposer автосохраняет пост, если пользователь ушёл из редактора на другую команду.
+func (h *Handler) leaveComposer(ctx context.Context, chatID int64) {
+	sess, ok := h.composer.take(chatID)
+	if !ok || !sess.hasContent {
+		return
+	}
+	if _, err := h.saveDraft(ctx, sess); err != nil {
+		h.reply(ctx, chatID, "Не удалось сохранить черновик.")
+		return
+	}
+	h.reply(ctx, chatID, "💾 Черновик сохранён. /drafts — список черновиков.")
+}
+
+// RunComposerSweeper автосохраняет брошенные редакторы, пока контекст не завершится.
+func (h *Handler) RunComposerSweeper(ctx context.Context) {
+	ticker := time.NewTicker(composerSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, sess := range h.composer.takeIdle(now) {
+				if !sess.hasContent {
+					continue
+				}
+				if _, err := h.saveDraft(ctx, sess); err != nil {
+					continue
+				}
+				h.reply(ctx, sess.chatID, "⏳ Вы долго не отвечали — пост сохранён в черновики. /drafts — список черновиков.")
+			}
+		}
+	}
+}
+
+func (h *Handler) saveDraft(ctx context.Context, sess *composeSession) (int64, error) {
+	resp, err := h.postClient.SaveDraft(ctx, &postv1.SaveDraftRequest{UserId: sess.userID, Draft: sess.draft, IdempotencyKey: idempotencyKey(ctx, "save-draft", sess.draft.GetDraftId())})
+	if err != nil {
+		h.logger.Warn("failed to save draft", zap.Error(err))
+		return 0, err
+	}
+	sess.draft.DraftId = resp.GetDraftId()
+	return resp.GetDraftId(), nil
+}
+
+func (h *Handler) handleDrafts(ctx context.Context, chatID, userID int64) {
+	resp, err := h.postClient.ListDrafts(ctx, &postv1.ListDraftsRequest{UserId: userID})
+	if err != nil {
+		h.logger.Warn("failed to list drafts", zap.Error(err))
+		h.reply(ctx, chatID, "Не удалось загрузить черновики, попробуйте позже.")
+		return
+	}
+	if len(resp.GetDrafts()) == 0 {
+		h.reply(ctx, chatID, "Черновиков нет. /new — написать пост.")
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString("📝 Ваши черновики:\n")
+	markup := &inlineKeyboardMarkup{}
+	for i, d := range resp.GetDrafts() {
+		if i == draftsListLimit {
+			break
+		}
+		text := truncateRunes(d.GetText(), 60)
+		if text == "" {
+			text = "(без текста)"
+		}
+		if d.GetMediaType() != "" {
+			text += " 📎"
+		}
+		fmt.Fprintf(&b, "\n%d. %s", i+1, text)
+		markup.InlineKeyboard = append(markup.InlineKeyboard, []inlineKeyboardButton{
+			{Text: fmt.Sprintf("📤 %d", i+1), CallbackData: fmt.Sprintf("draft:pub:%d", d.GetDraftId())},
+			{Text: fmt.Sprintf("🗑 %d", i+1), CallbackData: fmt.Sprintf("draft:del:%d", d.GetDraftId())},
+		})
+	}
+
+	if err := h.callTelegram(ctx, "sendMessage", sendMessageRequest{ChatID: chatID, Text: b.String(), ReplyMarkup: markup}); err != nil {
+		h.logger.Warn("failed to send drafts list", zap.Error(err))
+	}
+}
+
+// handleDraftCallback обрабатывает "draft:pub:<id>" и "draft:del:<id>".
+func (h *Handler) handleDraftCallback(ctx context.Context, cb *TelegramCallbackQuery, userID int64, args string) {
+	action, idPart, _ := strings.Cut(args, ":")
+	draftID, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil || draftID <= 0 {
+		h.answerCallback(ctx, cb.ID, "")
+		return
+	}
+	chatID := cb.Message.Chat.ID
+
+	switch action {
+	case "pub":
+		resp, err := h.postClient.PublishDraft(ctx, &postv1.PublishDraftRequest{UserId: userID, DraftId: draftID, IdempotencyKey: idempotencyKey(ctx, "publish-draft", draftID)})
+		switch {
+		case status.Code(err) == codes.InvalidArgument:
+			h.answerCallback(ctx, cb.ID, "")
+			h.reply(ctx, chatID, "Черновик не прошёл проверку: "+status.Convert(err).Message())
+		case status.Code(err) == codes.AlreadyExists:
+			h.answerCallback(ctx, cb.ID, "Такой пост уже недавно публиковался")
+		case status.Code(err) == codes.PermissionDenied:
+			h.answerCallback(ctx, cb.ID, "")
+			h.reply(ctx, chatID, "⛔ "+banText(err))
+		case status.Code(err) == codes.NotFound:
+			h.answerCallback(ctx, cb.ID, "Черновик уже опубликован или удалён")
+		case err != nil:
+			h.logger.Warn("failed to publish draft", zap.Error(err))
+			h.answerCallback(ctx, cb.ID, "Не удалось опубликовать")
+		default:
+			h.answerCallback(ctx, cb.ID, "Опубликовано")
+			h.reply(ctx, chatID, publishedText(resp))
+		}
+	case "del":
+		_, err := h.postClient.DeleteDraft(ctx, &postv1.DeleteDraftRequest{UserId: userID, DraftId: draftID, IdempotencyKey: idempotencyKey(ctx, "delete-draft", draftID)})
+		switch {
+		case status.Code(err) == codes.NotFound:
+			h.answerCallback(ctx, cb.ID, "Черновик уже удалён")
+		case err != nil:
+			h.logger.Warn("failed to delete draft", zap.Error(err))
+			h.answerCallback(ctx, cb.ID, "Не удалось удалить")
+		default:
+			h.answerCallback(ctx, cb.ID, "Черновик удалён")
+		}
+	default:
+		h.answerCallback(ctx, cb.ID, "")
+	}
+}
+
+func truncateRunes(text string, limit int) string {
+	text = strings.TrimSpace(text)
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit]) + "…"
+}
@@ -0,0 +1,127 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	interactionv1 "ghostnet/gen/go/proto/interaction/v1"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// engagementIdleTimeout — показ, длившийся дольше, считается брошенным: пользователь ушёл,
+	// а не читал пост, поэтому такой показ не отправляется вовсе.
+	engagementIdleTimeout   = 10 * time.Minute
+	engagementSweepInterval = time.Minute
+)
+
+// feedView — пост из ленты, который сейчас на экране у пользователя.
+type feedView struct {
+	userID         int64
+	postID         int64
+	deliveredAt    time.Time
+	commentsOpened bool
+}
+
+// engagementStore хранит последний показ ленты по user id. Время на экране
+// оценивается как промежуток между доставкой поста и следующим действием пользователя.
+type engagementStore struct {
+	mu    sync.Mutex
+	views map[int64]*feedView
+}
+
+func newEngagementStore() *engagementStore {
+	return &engagementStore{views: make(map[int64]*feedView)}
+}
+
+// start запоминает новый показ и возвращает предыдущий, если он был.
+func (e *engagementStore) start(userID, postID int64) (*feedView, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	prev, ok := e.views[userID]
+	e.views[userID] = &feedView{userID: userID, postID: postID, deliveredAt: time.Now()}
+	return prev, ok
+}
+
+// touch закрывает показ, если действие относится не к показанному посту.
+func (e *engagementStore) touch(userID, postID int64) (*feedView, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	view, ok := e.views[userID]
+	if !ok || (postID != 0 && view.postID == postID) {
+		return nil, false
+	}
+	delete(e.views, userID)
+	return view, true
+}
+
+func (e *engagementStore) markComments(userID, postID int64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if view, ok := e.views[userID]; ok && view.postID == postID {
+		view.commentsOpened = true
+	}
+}
+
+// dropIdle забывает брошенные показы и возвращает их число.
+func (e *engagementStore) dropIdle(now time.Time) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	dropped := 0
+	for userID, view := range e.views {
+		if now.Sub(view.deliveredAt) >= engagementIdleTimeout {
+			delete(e.views, userID)
+			dropped++
+		}
+	}
+	return dropped
+}
+
+// trackView начинает отсчёт показа поста из ленты, закрывая предыдущий.
+func (h *Handler) trackView(ctx context.Context, userID, postID int64) {
+	if prev, ok := h.engagement.start(userID, postID); ok {
+		h.recordEngagement(ctx, prev, time.Now())
+	}
+}
+
+// touchView отмечает действие пользователя; действие над показанным постом показ не закрывает.
+func (h *Handler) touchView(ctx context.Context, userID, postID int64) {
+	if view, ok := h.engagement.touch(userID, postID); ok {
+		h.recordEngagement(ctx, view, time.Now())
+	}
+}
+
+func (h *Handler) recordEngagement(ctx context.Context, view *feedView, endedAt time.Time) {
+	dwell := endedAt.Sub(view.deliveredAt)
+	if dwell >= engagementIdleTimeout {
+		return
+	}
+	_, err := h.interactionClient.RecordEngagement(ctx, &interactionv1.RecordEngagementRequest{
+		UserId:         view.userID,
+		PostId:         view.postID,
+		DwellMs:        dwell.Milliseconds(),
+		CommentsOpened: view.commentsOpened,
+	})
+	if err != nil {
+		h.logger.Warn("failed to record engagement", zap.Error(err))
+	}
+}
+
+// RunEngagementSweeper забывает брошенные показы, чтобы хранилище не росло.
+func (h *Handler) RunEngagementSweeper(ctx context.Context) {
+	ticker := time.NewTicker(engagementSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if n := h.engagement.dropIdle(now); n > 0 {
+				h.logger.Debug("abandoned feed views dropped", zap.Int("count", n))
+			}
+		}
+	}
+}
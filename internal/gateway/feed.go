@@ -0,0 +1,83 @@
+package gateway
+
+import (
+	"context"
+
+	feedv1 "ghostnet/gen/go/proto/feed/v1"
+	interactionv1 "ghostnet/gen/go/proto/interaction/v1"
+	postv1 "ghostnet/gen/go/proto/post/v1"
+
+	"go.uber.org/zap"
+)
+
+// handleNext выдаёт пользователю следующий пост ленты (опционально по хэштегу) и отмечает просмотр.
+func (h *Handler) handleNext(ctx context.Context, chatID, userID int64, tag string) {
+	next, err := h.feedClient.GetNextPostForUser(ctx, &feedv1.GetNextPostForUserRequest{UserId: userID, Tag: tag})
+	if err != nil {
+		h.logger.Warn("failed to fetch next post", zap.Error(err))
+		h.reply(ctx, chatID, "Не удалось загрузить ленту, попробуйте позже.")
+		return
+	}
+	if !next.GetHasPost() {
+		h.reply(ctx, chatID, "Новых постов пока нет.")
+		return
+	}
+
+	post, err := h.postClient.GetPost(ctx, &postv1.GetPostRequest{PostId: next.GetPostId()})
+	if err != nil {
+		h.logger.Warn("failed to fetch post", zap.Error(err))
+		h.reply(ctx, chatID, "Не удалось загрузить пост, попробуйте позже.")
+		return
+	}
+
+	if post.GetRepostOfPostId() != 0 {
+		if h.deliverRepost(ctx, chatID, userID, post) {
+			h.trackView(ctx, userID, post.GetPostId())
+		}
+		return
+	}
+
+	if err := h.sendPost(ctx, chatID, userID, post); err != nil {
+		h.logger.Warn("failed to deliver post", zap.Error(err))
+		return
+	}
+	h.markViewed(ctx, userID, post.GetPostId())
+	h.trackView(ctx, userID, post.GetPostId())
+}
+
+// deliverRepost показывает текст цитаты (если есть) и следом оригинал. Оригинал тоже
+// отмечается просмотренным, чтобы другие его репосты не попадали в ленту повторно.
+// Возвращает true, если оригинал доставлен.
+func (h *Handler) deliverRepost(ctx context.Context, chatID, userID int64, repost *postv1.GetPostResponse) bool {
+	original, err := h.postClient.GetPost(ctx, &postv1.GetPostRequest{PostId: repost.GetRepostOfPostId()})
+	if err != nil {
+		h.logger.Warn("failed to fetch reposted post", zap.Error(err))
+		h.reply(ctx, chatID, "Оригинал поста больше недоступен.")
+		h.markViewed(ctx, userID, repost.GetPostId())
+		return false
+	}
+
+	if repost.GetText() != "" {
+		err = h.sendPost(ctx, chatID, userID, repost)
+	} else {
+		err = h.sendMessage(ctx, chatID, "🔁 Репост")
+	}
+	if err != nil {
+		h.logger.Warn("failed to deliver repost", zap.Error(err))
+		return false
+	}
+	if err := h.sendPost(ctx, chatID, userID, original); err != nil {
+		h.logger.Warn("failed to deliver reposted post", zap.Error(err))
+		return false
+	}
+
+	h.markViewed(ctx, userID, repost.GetPostId())
+	h.markViewed(ctx, userID, original.GetPostId())
+	return true
+}
+
+func (h *Handler) markViewed(ctx context.Context, userID, postID int64) {
+	if _, err := h.interactionClient.MarkPostViewed(ctx, &interactionv1.MarkPostViewedRequest{UserId: userID, PostId: postID}); err != nil {
+		h.logger.Warn("failed to mark post viewed", zap.Error(err))
+	}
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	feedv1 "ghostnet/gen/go/proto/feed/v1"
 	interactionv1 "ghostnet/gen/go/proto/interaction/v1"
+	moderationv1 "ghostnet/gen/go/proto/moderation/v1"
 	postv1 "ghostnet/gen/go/proto/post/v1"
 	userv1 "ghostnet/gen/go/proto/user/v1"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
 )
 
 type Handler struct {
@@ -18,6 +22,10 @@ type Handler struct {
 	postClient        postv1.PostServiceClient
 	interactionClient interactionv1.InteractionServiceClient
 	feedClient        feedv1.FeedServiceClient
+	moderationClient  moderationv1.ModerationServiceClient
+	botToken          string
+	composer          *composerStore
+	engagement        *engagementStore
 	logger            *zap.Logger
 }
 
@@ -26,6 +34,8 @@ func NewHandler(
 	postClient postv1.PostServiceClient,
 	interactionClient interactionv1.InteractionServiceClient,
 	feedClient feedv1.FeedServiceClient,
+	moderationClient moderationv1.ModerationServiceClient,
+	botToken string,
 	logger *zap.Logger,
 ) *Handler {
 	return &Handler{
@@ -33,24 +43,62 @@ func NewHandler(
 		postClient:        postClient,
 		interactionClient: interactionClient,
 		feedClient:        feedClient,
+		moderationClient:  moderationClient,
+		botToken:          botToken,
+		composer:          newComposerStore(),
+		engagement:        newEngagementStore(),
 		logger:            logger,
 	}
 }
 
 type TelegramUpdate struct {
-	UpdateID int64 `json:"update_id"`
-	Message  *struct {
-		MessageID int64 `json:"message_id"`
-		Chat      *struct {
-			ID int64 `json:"id"`
-		} `json:"chat"`
-		Text string `json:"text"`
-	} `json:"message"`
+	UpdateID      int64                  `json:"update_id"`
+	Message       *TelegramMessage       `json:"message"`
+	CallbackQuery *TelegramCallbackQuery `json:"callback_query"`
+}
+
+type TelegramMessage struct {
+	MessageID int64 `json:"message_id"`
+	Chat      *struct {
+		ID int64 `json:"id"`
+	} `json:"chat"`
+	Text            string              `json:"text"`
+	Entities        []messageEntity     `json:"entities"`
+	Caption         string              `json:"caption"`
+	CaptionEntities []messageEntity     `json:"caption_entities"`
+	Photo           []telegramPhotoSize `json:"photo"`
+	Video           *telegramFile       `json:"video"`
+}
+
+type telegramFile struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+}
+
+// telegramPhotoSize — один из размеров фото; Telegram присылает их по возрастанию.
+type telegramPhotoSize = telegramFile
+
+// TelegramCallbackQuery — нажатие на inline-кнопку под сообщением бота.
+type TelegramCallbackQuery struct {
+	ID   string `json:"id"`
+	From *struct {
+		ID int64 `json:"id"`
+	} `json:"from"`
+	Message *TelegramMessage `json:"message"`
+	Data    string           `json:"data"`
 }
 
 // ProcessUpdate — общая логика обработки входящего апдейта (используется вебхуком и polling).
 func (h *Handler) ProcessUpdate(ctx context.Context, upd *TelegramUpdate) {
-	if upd == nil || upd.Message == nil || upd.Message.Chat == nil {
+	if upd == nil {
+		return
+	}
+	ctx = context.WithValue(ctx, updateIDKey{}, upd.UpdateID)
+	if upd.CallbackQuery != nil {
+		h.processCallback(ctx, upd.CallbackQuery)
+		return
+	}
+	if upd.Message == nil || upd.Message.Chat == nil {
 		return
 	}
 
@@ -59,8 +107,162 @@ func (h *Handler) ProcessUpdate(ctx context.Context, upd *TelegramUpdate) {
 		return
 	}
 
-	if _, err := h.userClient.GetOrCreateUser(ctx, &userv1.GetOrCreateUserRequest{TelegramId: telegramID}); err != nil {
+	userResp, err := h.userClient.GetOrCreateUser(ctx, &userv1.GetOrCreateUserRequest{TelegramId: telegramID})
+	if err != nil {
 		h.logger.Warn("failed to register user", zap.Error(err))
+		return
+	}
+	userID := userResp.GetUserId()
+
+	command, args := splitCommand(upd.Message.Text)
+	// /comments сам решает, относится ли он к показанному посту
+	if command != "/comments" {
+		h.touchView(ctx, userResp.GetUserId(), 0)
+	}
+	if command == "" {
+		h.handleComposeInput(ctx, telegramID, upd.Message)
+		return
+	}
+	// любая команда, кроме управляющих редактором, означает уход из редактора
+	if command != "/publish" && command != "/cancel" {
+		h.leaveComposer(ctx, telegramID)
+	}
+
+	switch command {
+	case "/new":
+		h.handleNewPost(ctx, telegramID, userID)
+	case "/publish":
+		h.handlePublish(ctx, telegramID, userID)
+	case "/cancel":
+		h.handleCancel(ctx, telegramID)
+	case "/drafts":
+		h.handleDrafts(ctx, telegramID, userID)
+	case "/next":
+		h.handleNext(ctx, telegramID, userID, args)
+	case "/search":
+		h.handleSearch(ctx, telegramID, args)
+	case "/comments":
+		h.handleComments(ctx, telegramID, userID, args)
+	case "/saved":
+		h.handleSaved(ctx, telegramID, userID, "")
+	case "/modqueue":
+		h.handleModQueue(ctx, telegramID, userID, userResp.GetIsModerator())
+	}
+}
+
+// processCallback разбирает callback_data вида "<действие>:<аргументы>" и отвечает на нажатие.
+func (h *Handler) processCallback(ctx context.Context, cb *TelegramCallbackQuery) {
+	if cb.From == nil || cb.From.ID <= 0 || cb.Message == nil || cb.Message.Chat == nil {
+		return
+	}
+
+	userResp, err := h.userClient.GetOrCreateUser(ctx, &userv1.GetOrCreateUserRequest{TelegramId: cb.From.ID})
+	if err != nil {
+		h.logger.Warn("failed to register user", zap.Error(err))
+		h.answerCallback(ctx, cb.ID, "Сервис временно недоступен")
+		return
+	}
+
+	action, args, _ := strings.Cut(cb.Data, ":")
+	h.touchView(ctx, userResp.GetUserId(), callbackPostID(action, args))
+
+	switch action {
+	case "poll":
+		h.handlePollCallback(ctx, cb, userResp.GetUserId(), args)
+	case "draft":
+		h.handleDraftCallback(ctx, cb, userResp.GetUserId(), args)
+	case "comments":
+		h.answerCallback(ctx, cb.ID, "")
+		h.handleComments(ctx, cb.Message.Chat.ID, userResp.GetUserId(), args)
+	case "save":
+		h.handleSaveCallback(ctx, cb, userResp.GetUserId(), args)
+	case "saved":
+		h.handleSavedCallback(ctx, cb, userResp.GetUserId(), args)
+	case "mod":
+		h.handleModCallback(ctx, cb, userResp.GetUserId(), userResp.GetIsModerator(), args)
+	default:
+		h.answerCallback(ctx, cb.ID, "")
+	}
+}
+
+type updateIDKey struct{}
+
+// idempotencyKey строит ключ идемпотентности из update_id: Telegram повторно доставляет
+// тот же апдейт, если прошлая доставка не подтвердилась, и повтор не должен выполнить действие дважды.
+// Один апдейт может породить несколько вызовов (сохранение черновика и его публикация),
+// поэтому в ключ входят метод и его цель — иначе второй вызов получил бы ответ первого.
+// Вне обработки апдейта (например, в фоновых задачах) ключ пуст.
+func idempotencyKey(ctx context.Context, method string, targetID int64) string {
+	updateID, ok := ctx.Value(updateIDKey{}).(int64)
+	if !ok || updateID == 0 {
+		return ""
+	}
+	return "tg-update-" + strconv.FormatInt(updateID, 10) + ":" + method + ":" + strconv.FormatInt(targetID, 10)
+}
+
+// banText описывает по-русски отказ PermissionDenied: сервисы прикладывают к нему
+// userv1.BanDetails, если действие запрещено баном.
+func banText(err error) string {
+	var details *userv1.BanDetails
+	for _, d := range status.Convert(err).Details() {
+		if bd, ok := d.(*userv1.BanDetails); ok {
+			details = bd
+			break
+		}
+	}
+	if details == nil {
+		return "Действие запрещено."
+	}
+
+	var text string
+	switch details.GetScope() {
+	case userv1.BanScope_BAN_SCOPE_POSTING:
+		text = "Вам запрещено публиковать посты"
+	case userv1.BanScope_BAN_SCOPE_COMMENTING:
+		text = "Вам запрещено писать комментарии"
+	default:
+		text = "Ваш аккаунт заблокирован"
+	}
+	if until, err := time.Parse(time.RFC3339, details.GetExpiresAt()); err == nil {
+		text += " до " + until.UTC().Format("02.01.2006 15:04") + " UTC"
+	} else {
+		text += " навсегда"
+	}
+	if details.GetReason() != "" {
+		text += ". Причина: " + details.GetReason()
+	}
+	return text + "."
+}
+
+// callbackPostID возвращает пост, к которому относится кнопка под ним, или 0.
+func callbackPostID(action, args string) int64 {
+	switch action {
+	case "poll", "save", "comments":
+		idPart, _, _ := strings.Cut(args, ":")
+		postID, err := strconv.ParseInt(idPart, 10, 64)
+		if err != nil {
+			return 0
+		}
+		return postID
+	}
+	return 0
+}
+
+// splitCommand отделяет команду бота от аргументов, отбрасывая суффикс @BotName.
+func splitCommand(text string) (string, string) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "/") {
+		return "", text
+	}
+	command, args, _ := strings.Cut(text, " ")
+	command, _, _ = strings.Cut(command, "@")
+	return strings.ToLower(command), strings.TrimSpace(args)
+}
+
+// reply отправляет ответ пользователю, логируя ошибки доставки.
+func (h *Handler) reply(ctx context.Context, chatID int64, text string) {
+	if err := h.sendMessage(ctx, chatID, text); err != nil {
+		h.logger.Warn("failed to send telegram message", zap.Error(err))
 	}
 }
 
@@ -0,0 +1,179 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	moderationv1 "ghostnet/gen/go/proto/moderation/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const modPreviewLength = 1000
+
+var reportReasonNames = map[moderationv1.ReportReason]string{
+	moderationv1.ReportReason_REPORT_REASON_SPAM:          "спам",
+	moderationv1.ReportReason_REPORT_REASON_HARASSMENT:    "травля",
+	moderationv1.ReportReason_REPORT_REASON_HATE:          "ненависть",
+	moderationv1.ReportReason_REPORT_REASON_VIOLENCE:      "насилие",
+	moderationv1.ReportReason_REPORT_REASON_SEXUAL:        "сексуальный контент",
+	moderationv1.ReportReason_REPORT_REASON_PERSONAL_INFO: "личные данные",
+	moderationv1.ReportReason_REPORT_REASON_ILLEGAL:       "незаконное",
+	moderationv1.ReportReason_REPORT_REASON_OTHER:         "другое",
+	moderationv1.ReportReason_REPORT_REASON_AUTO_HIDDEN:   "автоскрытие",
+	moderationv1.ReportReason_REPORT_REASON_AUTO_FLAGGED:  "автопометка",
+}
+
+var modActions = map[string]moderationv1.ResolutionAction{
+	"dismiss":   moderationv1.ResolutionAction_RESOLUTION_ACTION_DISMISS,
+	"hide":      moderationv1.ResolutionAction_RESOLUTION_ACTION_HIDE,
+	"ban":       moderationv1.ResolutionAction_RESOLUTION_ACTION_BAN_AUTHOR,
+	"shadowban": moderationv1.ResolutionAction_RESOLUTION_ACTION_BAN_AUTHOR,
+}
+
+// handleModQueue выполняет /modqueue: показывает модератору первый объект из очереди жалоб.
+func (h *Handler) handleModQueue(ctx context.Context, chatID, userID int64, isModerator bool) {
+	if !isModerator {
+		h.reply(ctx, chatID, "Команда доступна только модераторам.")
+		return
+	}
+	h.sendNextQueueItem(ctx, chatID, userID)
+}
+
+func (h *Handler) sendNextQueueItem(ctx context.Context, chatID, userID int64) {
+	resp, err := h.moderationClient.ListModerationQueue(ctx, &moderationv1.ListModerationQueueRequest{
+		ModeratorUserId: userID,
+		Limit:           1,
+	})
+	if status.Code(err) == codes.PermissionDenied {
+		h.reply(ctx, chatID, "Команда доступна только модераторам.")
+		return
+	}
+	if err != nil {
+		h.logger.Warn("failed to list moderation queue", zap.Error(err))
+		h.reply(ctx, chatID, "Не удалось загрузить очередь, попробуйте позже.")
+		return
+	}
+	if len(resp.GetItems()) == 0 {
+		h.reply(ctx, chatID, "✅ Очередь модерации пуста.")
+		return
+	}
+
+	item := resp.GetItems()[0]
+	id := item.GetReportId()
+	dismissText := "✅ Оставить"
+	if item.GetAutoHidden() {
+		dismissText = "♻️ Вернуть"
+	}
+	markup := &inlineKeyboardMarkup{InlineKeyboard: [][]inlineKeyboardButton{
+		{
+			{Text: dismissText, CallbackData: fmt.Sprintf("mod:dismiss:%d", id)},
+			{Text: "🙈 Скрыть", CallbackData: fmt.Sprintf("mod:hide:%d", id)},
+		},
+		{
+			{Text: "⛔ Бан", CallbackData: fmt.Sprintf("mod:ban:%d", id)},
+			{Text: "👻 Теневой бан", CallbackData: fmt.Sprintf("mod:shadowban:%d", id)},
+		},
+	}}
+	if err := h.callTelegram(ctx, "sendMessage", sendMessageRequest{ChatID: chatID, Text: renderQueueItem(item), ReplyMarkup: markup}); err != nil {
+		h.logger.Warn("failed to send moderation item", zap.Error(err))
+	}
+}
+
+// renderQueueItem показывает содержимое, причины жалоб и страйки автора, но не его личность.
+func renderQueueItem(item *moderationv1.QueueItem) string {
+	var b strings.Builder
+	if item.GetTargetType() == moderationv1.TargetType_TARGET_TYPE_COMMENT {
+		fmt.Fprintf(&b, "🚩 Комментарий к посту #%d\n\n", item.GetPostId())
+	} else {
+		fmt.Fprintf(&b, "🚩 Пост #%d\n\n", item.GetPostId())
+	}
+
+	text := truncateRunes(item.GetTextPreview(), modPreviewLength)
+	if text == "" {
+		text = "(без текста)"
+	}
+	b.WriteString(text)
+	if item.GetAutoHidden() {
+		b.WriteString("\n\n🤖 Скрыт автоматически")
+	}
+
+	reasons := make([]string, 0, len(item.GetReasons()))
+	for _, r := range item.GetReasons() {
+		if name, ok := reportReasonNames[r]; ok {
+			reasons = append(reasons, name)
+		}
+	}
+	fmt.Fprintf(&b, "\n\nПричины: %s", strings.Join(reasons, ", "))
+	fmt.Fprintf(&b, "\nЖалоб: %d, тяжесть: %d/5", item.GetReportCount(), item.GetSeverity())
+	fmt.Fprintf(&b, "\nСтрайков у автора: %d", item.GetAuthorStrikes())
+	return b.String()
+}
+
+// handleModCallback обрабатывает "mod:<dismiss|hide|ban|shadowban>:<report_id>".
+func (h *Handler) handleModCallback(ctx context.Context, cb *TelegramCallbackQuery, userID int64, isModerator bool, args string) {
+	if !isModerator {
+		h.answerCallback(ctx, cb.ID, "Только для модераторов")
+		return
+	}
+	actionName, idPart, _ := strings.Cut(args, ":")
+	action, ok := modActions[actionName]
+	reportID, err := strconv.ParseInt(idPart, 10, 64)
+	if !ok || err != nil || reportID <= 0 {
+		h.answerCallback(ctx, cb.ID, "")
+		return
+	}
+	chatID := cb.Message.Chat.ID
+
+	resp, err := h.moderationClient.ResolveReport(ctx, &moderationv1.ResolveReportRequest{
+		ModeratorUserId: userID,
+		ReportId:        reportID,
+		Action:          action,
+		ShadowBan:       actionName == "shadowban",
+	})
+	var result string
+	switch {
+	case status.Code(err) == codes.PermissionDenied:
+		h.answerCallback(ctx, cb.ID, "Недостаточно прав")
+		return
+	case status.Code(err) == codes.FailedPrecondition:
+		h.answerCallback(ctx, cb.ID, "Этого автора нельзя заблокировать")
+		return
+	case status.Code(err) == codes.NotFound:
+		h.answerCallback(ctx, cb.ID, "Жалоба уже рассмотрена")
+		result = "↩️ Уже рассмотрено другим модератором."
+	case err != nil:
+		h.logger.Warn("failed to resolve report", zap.Error(err))
+		h.answerCallback(ctx, cb.ID, "Не удалось применить решение")
+		return
+	default:
+		h.answerCallback(ctx, cb.ID, "Готово")
+		switch action {
+		case moderationv1.ResolutionAction_RESOLUTION_ACTION_DISMISS:
+			result = "✅ Оставлено."
+			if resp.GetRestored() {
+				result = "♻️ Пост возвращён."
+			}
+		case moderationv1.ResolutionAction_RESOLUTION_ACTION_HIDE:
+			result = "🙈 Скрыто."
+		default:
+			result = "⛔ Скрыто, автор заблокирован."
+			if actionName == "shadowban" {
+				result = "👻 Скрыто, автор в теневом бане."
+			}
+		}
+	}
+
+	if err := h.callTelegram(ctx, "editMessageText", editMessageTextRequest{
+		ChatID:    chatID,
+		MessageID: cb.Message.MessageID,
+		Text:      cb.Message.Text + "\n\n" + result,
+	}); err != nil {
+		h.logger.Warn("failed to edit moderation item", zap.Error(err))
+	}
+	h.sendNextQueueItem(ctx, chatID, userID)
+}
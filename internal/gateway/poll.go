@@ -0,0 +1,167 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	interactionv1 "ghostnet/gen/go/proto/interaction/v1"
+	postv1 "ghostnet/gen/go/proto/post/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const pollBarWidth = 10
+
+// sendPoll отправляет опрос с кнопками вариантов. Entities вопроса сохраняются,
+// так как вопрос стоит в начале сообщения.
+func (h *Handler) sendPoll(ctx context.Context, chatID, userID int64, post *postv1.GetPostResponse) error {
+	results, err := h.interactionClient.GetPollResults(ctx, &interactionv1.GetPollResultsRequest{UserId: userID, PostId: post.GetPostId()})
+	if err != nil {
+		return fmt.Errorf("get poll results: %w", err)
+	}
+
+	return h.callTelegram(ctx, "sendMessage", sendMessageRequest{
+		ChatID:      chatID,
+		Text:        renderPoll(results),
+		Entities:    fromProtoEntities(post.GetEntities()),
+		ReplyMarkup: pollKeyboard(post.GetPostId(), results),
+	})
+}
+
+// handlePollCallback обрабатывает "poll:<post_id>:<option_id>" (голос) и "poll:<post_id>" (обновление).
+func (h *Handler) handlePollCallback(ctx context.Context, cb *TelegramCallbackQuery, userID int64, args string) {
+	postPart, optionPart, hasOption := strings.Cut(args, ":")
+	postID, err := strconv.ParseInt(postPart, 10, 64)
+	if err != nil || postID <= 0 {
+		h.answerCallback(ctx, cb.ID, "")
+		return
+	}
+
+	var results *interactionv1.GetPollResultsResponse
+	notice := ""
+	if hasOption {
+		optionID, err := strconv.ParseInt(optionPart, 10, 64)
+		if err != nil || optionID <= 0 {
+			h.answerCallback(ctx, cb.ID, "")
+			return
+		}
+		resp, err := h.interactionClient.VotePoll(ctx, &interactionv1.VotePollRequest{
+			UserId:         userID,
+			PostId:         postID,
+			OptionIds:      []int64{optionID},
+			IdempotencyKey: idempotencyKey(ctx, "vote-poll", postID),
+		})
+		switch {
+		case status.Code(err) == codes.FailedPrecondition:
+			notice = "Вы уже проголосовали"
+		case status.Code(err) == codes.PermissionDenied:
+			h.answerCallback(ctx, cb.ID, "⛔ "+banText(err))
+			return
+		case err != nil:
+			h.logger.Warn("failed to vote poll", zap.Error(err))
+			h.answerCallback(ctx, cb.ID, "Не удалось проголосовать")
+			return
+		default:
+			results = resp.GetResults()
+			notice = "Голос учтён"
+		}
+	}
+
+	if results == nil {
+		results, err = h.interactionClient.GetPollResults(ctx, &interactionv1.GetPollResultsRequest{UserId: userID, PostId: postID})
+		if err != nil {
+			h.logger.Warn("failed to get poll results", zap.Error(err))
+			h.answerCallback(ctx, cb.ID, "Опрос недоступен")
+			return
+		}
+	}
+	h.answerCallback(ctx, cb.ID, notice)
+
+	post, err := h.postClient.GetPost(ctx, &postv1.GetPostRequest{PostId: postID})
+	if err != nil {
+		h.logger.Warn("failed to fetch poll post", zap.Error(err))
+		return
+	}
+
+	if err := h.callTelegram(ctx, "editMessageText", editMessageTextRequest{
+		ChatID:      cb.Message.Chat.ID,
+		MessageID:   cb.Message.MessageID,
+		Text:        renderPoll(results),
+		Entities:    fromProtoEntities(post.GetEntities()),
+		ReplyMarkup: pollKeyboard(postID, results),
+	}); err != nil {
+		// Telegram отвечает 400, если текст не изменился, — это не ошибка
+		h.logger.Debug("failed to refresh poll message", zap.Error(err))
+	}
+}
+
+func renderPoll(results *interactionv1.GetPollResultsResponse) string {
+	var b strings.Builder
+	b.WriteString(results.GetQuestion())
+	b.WriteString("\n")
+
+	for _, opt := range results.GetOptions() {
+		b.WriteString("\n")
+		if !results.GetResultsVisible() {
+			b.WriteString("• " + opt.GetText() + "\n")
+			continue
+		}
+		mark := ""
+		if opt.GetChosen() {
+			mark = " ✅"
+		}
+		fmt.Fprintf(&b, "%s%s\n%s %d%% (%d)\n", opt.GetText(), mark, percentBar(opt.GetPercent()), opt.GetPercent(), opt.GetVotes())
+	}
+
+	b.WriteString("\n")
+	switch {
+	case !results.GetResultsVisible():
+		b.WriteString("📊 Результаты откроются после голосования")
+	case results.GetMultipleChoice():
+		fmt.Fprintf(&b, "📊 Проголосовали: %d · можно выбрать несколько", results.GetTotalVoters())
+	default:
+		fmt.Fprintf(&b, "📊 Проголосовали: %d", results.GetTotalVoters())
+	}
+	return b.String()
+}
+
+func percentBar(percent int32) string {
+	filled := int(percent) * pollBarWidth / 100
+	return strings.Repeat("▓", filled) + strings.Repeat("░", pollBarWidth-filled)
+}
+
+// pollKeyboard показывает варианты, пока за них можно голосовать, и кнопку обновления результатов.
+func pollKeyboard(postID int64, results *interactionv1.GetPollResultsResponse) *inlineKeyboardMarkup {
+	voted := false
+	for _, opt := range results.GetOptions() {
+		if opt.GetChosen() {
+			voted = true
+			break
+		}
+	}
+
+	markup := &inlineKeyboardMarkup{}
+	if !voted || results.GetMultipleChoice() {
+		for _, opt := range results.GetOptions() {
+			text := opt.GetText()
+			if opt.GetChosen() {
+				text = "✅ " + text
+			}
+			markup.InlineKeyboard = append(markup.InlineKeyboard, []inlineKeyboardButton{{
+				Text:         text,
+				CallbackData: fmt.Sprintf("poll:%d:%d", postID, opt.GetOptionId()),
+			}})
+		}
+	}
+	if results.GetResultsVisible() {
+		markup.InlineKeyboard = append(markup.InlineKeyboard, []inlineKeyboardButton{{
+			Text:         "🔄 Обновить",
+			CallbackData: fmt.Sprintf("poll:%d", postID),
+		}})
+	}
+	return markup
+}
@@ -0,0 +1,140 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	interactionv1 "ghostnet/gen/go/proto/interaction/v1"
+	postv1 "ghostnet/gen/go/proto/post/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const savedPageSize = 5
+
+// handleSaveCallback обрабатывает "save:<post_id>" под постом: повторное нажатие убирает пост из закладок.
+func (h *Handler) handleSaveCallback(ctx context.Context, cb *TelegramCallbackQuery, userID int64, args string) {
+	postID, err := strconv.ParseInt(args, 10, 64)
+	if err != nil || postID <= 0 {
+		h.answerCallback(ctx, cb.ID, "")
+		return
+	}
+
+	resp, err := h.interactionClient.SavePost(ctx, &interactionv1.SavePostRequest{UserId: userID, PostId: postID, IdempotencyKey: idempotencyKey(ctx, "save-post", postID)})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		h.answerCallback(ctx, cb.ID, "Пост больше недоступен")
+		return
+	case err != nil:
+		h.logger.Warn("failed to save post", zap.Error(err))
+		h.answerCallback(ctx, cb.ID, "Не удалось сохранить пост")
+		return
+	case !resp.GetAlreadySaved():
+		h.answerCallback(ctx, cb.ID, "🔖 Сохранено. /saved — закладки")
+		return
+	}
+
+	if _, err := h.interactionClient.UnsavePost(ctx, &interactionv1.UnsavePostRequest{UserId: userID, PostId: postID, IdempotencyKey: idempotencyKey(ctx, "unsave-post", postID)}); err != nil {
+		h.logger.Warn("failed to unsave post", zap.Error(err))
+		h.answerCallback(ctx, cb.ID, "Не удалось убрать из закладок")
+		return
+	}
+	h.answerCallback(ctx, cb.ID, "Убрано из закладок")
+}
+
+// handleSaved выполняет /saved: показывает страницу закладок с кнопками открытия и удаления.
+func (h *Handler) handleSaved(ctx context.Context, chatID, userID int64, pageToken string) {
+	resp, err := h.interactionClient.ListSavedPosts(ctx, &interactionv1.ListSavedPostsRequest{
+		UserId:    userID,
+		PageSize:  savedPageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		h.logger.Warn("failed to list saved posts", zap.Error(err))
+		h.reply(ctx, chatID, "Не удалось загрузить закладки, попробуйте позже.")
+		return
+	}
+	if len(resp.GetPosts()) == 0 {
+		if pageToken == "" {
+			h.reply(ctx, chatID, "🔖 Закладок пока нет. Нажмите 🔖 под постом, чтобы сохранить его.")
+		} else {
+			h.reply(ctx, chatID, "🔖 Больше закладок нет.")
+		}
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString("🔖 Сохранённые посты:\n")
+	markup := &inlineKeyboardMarkup{}
+	for i, p := range resp.GetPosts() {
+		text := truncateRunes(p.GetTextPreview(), 60)
+		if text == "" {
+			text = "(без текста)"
+		}
+		if p.GetMediaType() != "" {
+			text += " 📎"
+		}
+		fmt.Fprintf(&b, "\n%d. %s", i+1, text)
+		markup.InlineKeyboard = append(markup.InlineKeyboard, []inlineKeyboardButton{
+			{Text: fmt.Sprintf("📖 %d", i+1), CallbackData: fmt.Sprintf("saved:open:%d", p.GetPostId())},
+			{Text: fmt.Sprintf("🗑 %d", i+1), CallbackData: fmt.Sprintf("saved:del:%d", p.GetPostId())},
+		})
+	}
+	if resp.GetHasMore() {
+		markup.InlineKeyboard = append(markup.InlineKeyboard, []inlineKeyboardButton{
+			{Text: "➡️ Дальше", CallbackData: "saved:more:" + resp.GetNextPageToken()},
+		})
+	}
+
+	if err := h.callTelegram(ctx, "sendMessage", sendMessageRequest{ChatID: chatID, Text: b.String(), ReplyMarkup: markup}); err != nil {
+		h.logger.Warn("failed to send saved posts", zap.Error(err))
+	}
+}
+
+// handleSavedCallback обрабатывает "saved:open:<post_id>", "saved:del:<post_id>" и "saved:more:<token>".
+func (h *Handler) handleSavedCallback(ctx context.Context, cb *TelegramCallbackQuery, userID int64, args string) {
+	action, arg, _ := strings.Cut(args, ":")
+	chatID := cb.Message.Chat.ID
+
+	if action == "more" {
+		h.answerCallback(ctx, cb.ID, "")
+		h.handleSaved(ctx, chatID, userID, arg)
+		return
+	}
+
+	postID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || postID <= 0 {
+		h.answerCallback(ctx, cb.ID, "")
+		return
+	}
+
+	switch action {
+	case "open":
+		post, err := h.postClient.GetPost(ctx, &postv1.GetPostRequest{PostId: postID})
+		if err != nil {
+			h.answerCallback(ctx, cb.ID, "Пост больше недоступен")
+			return
+		}
+		h.answerCallback(ctx, cb.ID, "")
+		if post.GetRepostOfPostId() != 0 {
+			h.deliverRepost(ctx, chatID, userID, post)
+			return
+		}
+		if err := h.sendPost(ctx, chatID, userID, post); err != nil {
+			h.logger.Warn("failed to deliver saved post", zap.Error(err))
+		}
+	case "del":
+		if _, err := h.interactionClient.UnsavePost(ctx, &interactionv1.UnsavePostRequest{UserId: userID, PostId: postID, IdempotencyKey: idempotencyKey(ctx, "unsave-post", postID)}); err != nil {
+			h.logger.Warn("failed to unsave post", zap.Error(err))
+			h.answerCallback(ctx, cb.ID, "Не удалось убрать из закладок")
+			return
+		}
+		h.answerCallback(ctx, cb.ID, "Убрано из закладок")
+	default:
+		h.answerCallback(ctx, cb.ID, "")
+	}
+}
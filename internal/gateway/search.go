@@ -0,0 +1,45 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	postv1 "ghostnet/gen/go/proto/post/v1"
+
+	"go.uber.org/zap"
+)
+
+const searchResultsLimit = 5
+
+// handleSearch выполняет /search <text> и отвечает списком найденных фрагментов.
+func (h *Handler) handleSearch(ctx context.Context, chatID int64, query string) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		h.reply(ctx, chatID, "Использование: /search <текст>")
+		return
+	}
+
+	resp, err := h.postClient.SearchPosts(ctx, &postv1.SearchPostsRequest{Query: query, Limit: searchResultsLimit})
+	if err != nil {
+		h.logger.Warn("failed to search posts", zap.Error(err))
+		h.reply(ctx, chatID, "Не удалось выполнить поиск, попробуйте позже.")
+		return
+	}
+
+	if len(resp.GetResults()) == 0 {
+		h.reply(ctx, chatID, "🔎 Ничего не найдено.")
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "🔎 Результаты по запросу «%s»:\n", query)
+	for i, res := range resp.GetResults() {
+		fmt.Fprintf(&b, "\n%d. %s", i+1, res.GetSnippet())
+		if res.GetMediaType() != "" {
+			b.WriteString(" 📎")
+		}
+		b.WriteString("\n")
+	}
+	h.reply(ctx, chatID, b.String())
+}
@@ -0,0 +1,171 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	postv1 "ghostnet/gen/go/proto/post/v1"
+
+	"go.uber.org/zap"
+)
+
+type messageEntity struct {
+	Type          string `json:"type"`
+	Offset        int32  `json:"offset"`
+	Length        int32  `json:"length"`
+	URL           string `json:"url,omitempty"`
+	Language      string `json:"language,omitempty"`
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+}
+
+type inlineKeyboardButton struct {
+	Text         string `json:"text"`
+	CallbackData string `json:"callback_data,omitempty"`
+}
+
+type inlineKeyboardMarkup struct {
+	InlineKeyboard [][]inlineKeyboardButton `json:"inline_keyboard"`
+}
+
+type sendMessageRequest struct {
+	ChatID      int64                 `json:"chat_id"`
+	Text        string                `json:"text"`
+	Entities    []messageEntity       `json:"entities,omitempty"`
+	ReplyMarkup *inlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+type editMessageTextRequest struct {
+	ChatID      int64                 `json:"chat_id"`
+	MessageID   int64                 `json:"message_id"`
+	Text        string                `json:"text"`
+	Entities    []messageEntity       `json:"entities,omitempty"`
+	ReplyMarkup *inlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+type answerCallbackQueryRequest struct {
+	CallbackQueryID string `json:"callback_query_id"`
+	Text            string `json:"text,omitempty"`
+}
+
+type sendMediaRequest struct {
+	ChatID          int64                 `json:"chat_id"`
+	Photo           string                `json:"photo,omitempty"`
+	Video           string                `json:"video,omitempty"`
+	Caption         string                `json:"caption,omitempty"`
+	CaptionEntities []messageEntity       `json:"caption_entities,omitempty"`
+	ReplyMarkup     *inlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// sendMessage отправляет текстовое сообщение в чат пользователя.
+func (h *Handler) sendMessage(ctx context.Context, chatID int64, text string) error {
+	return h.callTelegram(ctx, "sendMessage", sendMessageRequest{ChatID: chatID, Text: text})
+}
+
+// answerCallback снимает «часики» с нажатой кнопки, при необходимости показывая подсказку.
+func (h *Handler) answerCallback(ctx context.Context, callbackID, text string) {
+	if err := h.callTelegram(ctx, "answerCallbackQuery", answerCallbackQueryRequest{CallbackQueryID: callbackID, Text: text}); err != nil {
+		h.logger.Warn("failed to answer callback query", zap.Error(err))
+	}
+}
+
+// sendPost доставляет пост с исходным форматированием автора.
+func (h *Handler) sendPost(ctx context.Context, chatID, userID int64, post *postv1.GetPostResponse) error {
+	entities := fromProtoEntities(post.GetEntities())
+
+	switch post.GetMediaType() {
+	case "poll":
+		return h.sendPoll(ctx, chatID, userID, post)
+	case "photo":
+		return h.callTelegram(ctx, "sendPhoto", sendMediaRequest{
+			ChatID:          chatID,
+			Photo:           post.GetTelegramFileId(),
+			Caption:         post.GetText(),
+			CaptionEntities: entities,
+			ReplyMarkup:     postKeyboard(post.GetPostId()),
+		})
+	case "video":
+		return h.callTelegram(ctx, "sendVideo", sendMediaRequest{
+			ChatID:          chatID,
+			Video:           post.GetTelegramFileId(),
+			Caption:         post.GetText(),
+			CaptionEntities: entities,
+			ReplyMarkup:     postKeyboard(post.GetPostId()),
+		})
+	default:
+		return h.callTelegram(ctx, "sendMessage", sendMessageRequest{
+			ChatID:      chatID,
+			Text:        post.GetText(),
+			Entities:    entities,
+			ReplyMarkup: postKeyboard(post.GetPostId()),
+		})
+	}
+}
+
+// postKeyboard — кнопки под доставленным постом.
+func postKeyboard(postID int64) *inlineKeyboardMarkup {
+	return &inlineKeyboardMarkup{InlineKeyboard: [][]inlineKeyboardButton{{
+		{Text: "💬 Комментарии", CallbackData: fmt.Sprintf("comments:%d", postID)},
+		{Text: "🔖", CallbackData: fmt.Sprintf("save:%d", postID)},
+	}}}
+}
+
+func toProtoEntities(entities []messageEntity) []*postv1.MessageEntity {
+	out := make([]*postv1.MessageEntity, 0, len(entities))
+	for _, e := range entities {
+		out = append(out, &postv1.MessageEntity{
+			Type:          e.Type,
+			Offset:        e.Offset,
+			Length:        e.Length,
+			Url:           e.URL,
+			Language:      e.Language,
+			CustomEmojiId: e.CustomEmojiID,
+		})
+	}
+	return out
+}
+
+func fromProtoEntities(entities []*postv1.MessageEntity) []messageEntity {
+	out := make([]messageEntity, 0, len(entities))
+	for _, e := range entities {
+		out = append(out, messageEntity{
+			Type:          e.GetType(),
+			Offset:        e.GetOffset(),
+			Length:        e.GetLength(),
+			URL:           e.GetUrl(),
+			Language:      e.GetLanguage(),
+			CustomEmojiID: e.GetCustomEmojiId(),
+		})
+	}
+	return out
+}
+
+func (h *Handler) callTelegram(ctx context.Context, method string, payload any) error {
+	if h.botToken == "" || h.botToken == "SET_ME" {
+		return nil
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", method, err)
+	}
+
+	url := "https://api.telegram.org/bot" + h.botToken + "/" + method
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("telegram %s status %d", method, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package interaction
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const counterReconcileBatch = 500
+
+// counterDriftTotal — сколько постов со сбившимися счётчиками нашла сверка с момента запуска.
+var counterDriftTotal = expvar.NewInt("post_counters_drift_total")
+
+// Counters — денормализованные счётчики поста из post_counters.
+type Counters struct {
+	Likes    int64
+	Dislikes int64
+	Comments int64
+	Views    int64
+	Saves    int64
+}
+
+// voteDelta переводит смену голоса previous -> current в изменение счётчиков; 0 — голоса нет.
+func voteDelta(previous, current int16) Counters {
+	var d Counters
+	switch previous {
+	case 1:
+		d.Likes--
+	case -1:
+		d.Dislikes--
+	}
+	switch current {
+	case 1:
+		d.Likes++
+	case -1:
+		d.Dislikes++
+	}
+	return d
+}
+
+// bumpCounters прибавляет d к счётчикам поста в транзакции вызывающего.
+func bumpCounters(ctx context.Context, tx pgx.Tx, postID int64, d Counters) error {
+	if d == (Counters{}) {
+		return nil
+	}
+	_, err := tx.Exec(ctx, `
+		INSERT INTO post_counters (post_id, likes, dislikes, comments, views, saves)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (post_id) DO UPDATE
+		SET likes      = post_counters.likes + EXCLUDED.likes,
+		    dislikes   = post_counters.dislikes + EXCLUDED.dislikes,
+		    comments   = post_counters.comments + EXCLUDED.comments,
+		    views      = post_counters.views + EXCLUDED.views,
+		    saves      = post_counters.saves + EXCLUDED.saves,
+		    updated_at = NOW()
+	`, postID, d.Likes, d.Dislikes, d.Comments, d.Views, d.Saves)
+	if err != nil {
+		return fmt.Errorf("bump counters: %w", err)
+	}
+	return nil
+}
+
+// CounterDrift — расхождение сохранённых счётчиков с пересчитанными.
+type CounterDrift struct {
+	PostID int64
+	Stored Counters
+	Actual Counters
+}
+
+// ReconcileCounters пересчитывает счётчики постов с id больше afterID (не больше limit постов),
+// исправляет расхождения и возвращает их вместе с последним проверенным id; 0 — посты кончились.
+//
+// Голоса и комментарии пользователей под теневым баном не считаются, поэтому после снятия бана
+// их действия попадают в счётчики при ближайшей сверке.
+//
+// Строки счётчиков блокируются до пересчёта: транзакция голоса, начатая раньше, успеет
+// закоммититься и попадёт в пересчёт, а начатая позже прибавит своё изменение к исправленному значению.
+func (r *Repository) ReconcileCounters(ctx context.Context, afterID int64, limit int32) ([]CounterDrift, int64, error) {
+	var ids []int64
+	rows, err := r.pool.Query(ctx, `SELECT id FROM posts WHERE id > $1 ORDER BY id LIMIT $2`, afterID, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list posts: %w", err)
+	}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, 0, fmt.Errorf("scan post id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("iterate posts: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, 0, nil
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+		SELECT post_id FROM post_counters WHERE post_id = ANY($1) ORDER BY post_id FOR UPDATE
+	`, ids); err != nil {
+		return nil, 0, fmt.Errorf("lock counters: %w", err)
+	}
+
+	rows, err = tx.Query(ctx, `
+		SELECT p.id,
+		       pc.post_id IS NOT NULL,
+		       COALESCE(pc.likes, 0), COALESCE(pc.dislikes, 0), COALESCE(pc.comments, 0),
+		       COALESCE(pc.views, 0), COALESCE(pc.saves, 0),
+		       (SELECT COUNT(*) FROM post_votes v
+		        WHERE v.post_id = p.id AND v.vote_type = 1 AND NOT `+shadowBanned("v.user_id", voteBanScopes)+`),
+		       (SELECT COUNT(*) FROM post_votes v
+		        WHERE v.post_id = p.id AND v.vote_type = -1 AND NOT `+shadowBanned("v.user_id", voteBanScopes)+`),
+		       (SELECT COUNT(*) FROM post_comments c
+		        WHERE c.post_id = p.id AND c.is_deleted = FALSE AND NOT `+shadowBanned("c.user_id", commentBanScopes)+`),
+		       (SELECT COUNT(*) FROM post_views vw WHERE vw.post_id = p.id),
+		       (SELECT COUNT(*) FROM post_saves sv WHERE sv.post_id = p.id)
+		FROM posts p
+		LEFT JOIN post_counters pc ON pc.post_id = p.id
+		WHERE p.id = ANY($1)
+	`, ids)
+	if err != nil {
+		return nil, 0, fmt.Errorf("recount: %w", err)
+	}
+	var drifts []CounterDrift
+	missing := make(map[int64]bool)
+	for rows.Next() {
+		var d CounterDrift
+		var exists bool
+		if err := rows.Scan(&d.PostID, &exists,
+			&d.Stored.Likes, &d.Stored.Dislikes, &d.Stored.Comments, &d.Stored.Views, &d.Stored.Saves,
+			&d.Actual.Likes, &d.Actual.Dislikes, &d.Actual.Comments, &d.Actual.Views, &d.Actual.Saves); err != nil {
+			rows.Close()
+			return nil, 0, fmt.Errorf("scan recount: %w", err)
+		}
+		if d.Stored != d.Actual {
+			drifts = append(drifts, d)
+			missing[d.PostID] = !exists
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("iterate recount: %w", err)
+	}
+
+	for _, d := range drifts {
+		if missing[d.PostID] {
+			// строки не было, и заблокировать её было нельзя: параллельная вставка выигрывает,
+			// а возможное расхождение поправит следующая сверка
+			_, err = tx.Exec(ctx, `
+				INSERT INTO post_counters (post_id, likes, dislikes, comments, views, saves)
+				VALUES ($1, $2, $3, $4, $5, $6)
+				ON CONFLICT (post_id) DO NOTHING
+			`, d.PostID, d.Actual.Likes, d.Actual.Dislikes, d.Actual.Comments, d.Actual.Views, d.Actual.Saves)
+		} else {
+			_, err = tx.Exec(ctx, `
+				UPDATE post_counters
+				SET likes = $2, dislikes = $3, comments = $4, views = $5, saves = $6, updated_at = NOW()
+				WHERE post_id = $1
+			`, d.PostID, d.Actual.Likes, d.Actual.Dislikes, d.Actual.Comments, d.Actual.Views, d.Actual.Saves)
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("fix counters: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, 0, fmt.Errorf("commit: %w", err)
+	}
+	return drifts, ids[len(ids)-1], nil
+}
+
+// RunCounterReconciler раз в interval сверяет post_counters с исходными таблицами,
+// исправляет расхождения и пишет их в лог, пока контекст не завершится.
+func (s *Service) RunCounterReconciler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		s.reconcileCounters(ctx)
+	}
+}
+
+func (s *Service) reconcileCounters(ctx context.Context) {
+	var afterID, drifted int64
+	for {
+		drifts, lastID, err := s.repo.ReconcileCounters(ctx, afterID, counterReconcileBatch)
+		if err != nil {
+			s.logger.Error("failed to reconcile post counters", zap.Int64("after_post_id", afterID), zap.Error(err))
+			return
+		}
+		if lastID == 0 {
+			break
+		}
+		afterID = lastID
+
+		for _, d := range drifts {
+			s.logger.Warn("post counters drift fixed",
+				zap.Int64("post_id", d.PostID),
+				zap.Any("stored", d.Stored),
+				zap.Any("actual", d.Actual),
+			)
+		}
+		drifted += int64(len(drifts))
+		counterDriftTotal.Add(int64(len(drifts)))
+	}
+	s.logger.Info("post counters reconciled", zap.Int64("drifted", drifted))
+}
@@ -37,12 +37,189 @@ CREATE TABLE IF NOT EXISTS post_views (
     UNIQUE (post_id, user_id)
 );`
 
+const createCommentsPostIndex = `
+CREATE INDEX IF NOT EXISTS idx_post_comments_post_created ON post_comments (post_id, created_at DESC, id DESC)
+    WHERE is_deleted = FALSE;`
+
+const addCommentEditedAtColumn = `
+ALTER TABLE post_comments ADD COLUMN IF NOT EXISTS edited_at TIMESTAMPTZ;`
+
+const addCommentParentColumns = `
+ALTER TABLE post_comments
+    ADD COLUMN IF NOT EXISTS parent_comment_id BIGINT REFERENCES post_comments(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS depth SMALLINT NOT NULL DEFAULT 0;`
+
+const createCommentsParentIndex = `
+CREATE INDEX IF NOT EXISTS idx_post_comments_parent ON post_comments (parent_comment_id, created_at)
+    WHERE parent_comment_id IS NOT NULL;`
+
+// post_reactions хранит эмодзи-реакции отдельно от post_votes: одна реакция пользователя на пост.
+const createReactionsTable = `
+CREATE TABLE IF NOT EXISTS post_reactions (
+    post_id    BIGINT NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    user_id    BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reaction   VARCHAR(32) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (post_id, user_id)
+);`
+
+const createReactionsPostIndex = `
+CREATE INDEX IF NOT EXISTS idx_post_reactions_post_reaction ON post_reactions (post_id, reaction);`
+
+const createCommentVotesTable = `
+CREATE TABLE IF NOT EXISTS comment_votes (
+    comment_id BIGINT NOT NULL REFERENCES post_comments(id) ON DELETE CASCADE,
+    user_id    BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    vote_type  SMALLINT NOT NULL CHECK (vote_type IN (-1, 1)),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (comment_id, user_id)
+);`
+
+// comment_like_milestones не даёт уведомить об одном рубеже дважды, если лайк сняли и поставили снова
+const createCommentMilestonesTable = `
+CREATE TABLE IF NOT EXISTS comment_like_milestones (
+    comment_id BIGINT NOT NULL REFERENCES post_comments(id) ON DELETE CASCADE,
+    milestone  INTEGER NOT NULL,
+    reached_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (comment_id, milestone)
+);`
+
+// poll_voters фиксирует факт участия в опросе: по нему считается число
+// проголосовавших и запрещается повторный выбор в опросе с одним вариантом.
+const createPollVotersTable = `
+CREATE TABLE IF NOT EXISTS poll_voters (
+    post_id    BIGINT NOT NULL REFERENCES polls(post_id) ON DELETE CASCADE,
+    user_id    BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (post_id, user_id)
+);`
+
+const createPollVotesTable = `
+CREATE TABLE IF NOT EXISTS poll_votes (
+    option_id  BIGINT NOT NULL REFERENCES poll_options(id) ON DELETE CASCADE,
+    post_id    BIGINT NOT NULL REFERENCES polls(post_id) ON DELETE CASCADE,
+    user_id    BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (option_id, user_id)
+);`
+
+const createPollVotesUserIndex = `
+CREATE INDEX IF NOT EXISTS idx_poll_votes_post_user ON poll_votes (post_id, user_id);`
+
+// comment_flags — отметки для модерации, поставленные фильтром при создании или правке комментария
+const createCommentFlagsTable = `
+CREATE TABLE IF NOT EXISTS comment_flags (
+    id         BIGSERIAL PRIMARY KEY,
+    comment_id BIGINT NOT NULL REFERENCES post_comments(id) ON DELETE CASCADE,
+    reason     VARCHAR(32) NOT NULL,
+    details    TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);`
+
+const createCommentFlagsIndex = `
+CREATE INDEX IF NOT EXISTS idx_comment_flags_comment ON comment_flags (comment_id);`
+
+const createSavesTable = `
+CREATE TABLE IF NOT EXISTS post_saves (
+    user_id    BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    post_id    BIGINT NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, post_id)
+);`
+
+const createSavesUserIndex = `
+CREATE INDEX IF NOT EXISTS idx_post_saves_user_created ON post_saves (user_id, created_at DESC, post_id DESC);`
+
+const createSavesPostIndex = `
+CREATE INDEX IF NOT EXISTS idx_post_saves_post ON post_saves (post_id);`
+
+// post_counters хранит счётчики поста, которые обновляются в той же транзакции, что и голос,
+// комментарий, просмотр или закладка; сверку с исходными таблицами делает RunCounterReconciler.
+const createCountersTable = `
+CREATE TABLE IF NOT EXISTS post_counters (
+    post_id    BIGINT PRIMARY KEY REFERENCES posts(id) ON DELETE CASCADE,
+    likes      BIGINT NOT NULL DEFAULT 0,
+    dislikes   BIGINT NOT NULL DEFAULT 0,
+    comments   BIGINT NOT NULL DEFAULT 0,
+    views      BIGINT NOT NULL DEFAULT 0,
+    saves      BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);`
+
+// backfillCounters заполняет счётчики постов, у которых их ещё нет; на заполненной базе ничего не делает.
+const backfillCounters = `
+INSERT INTO post_counters (post_id, likes, dislikes, comments, views, saves)
+SELECT p.id,
+       (SELECT COUNT(*) FROM post_votes v WHERE v.post_id = p.id AND v.vote_type = 1),
+       (SELECT COUNT(*) FROM post_votes v WHERE v.post_id = p.id AND v.vote_type = -1),
+       (SELECT COUNT(*) FROM post_comments c WHERE c.post_id = p.id AND c.is_deleted = FALSE),
+       (SELECT COUNT(*) FROM post_views vw WHERE vw.post_id = p.id),
+       (SELECT COUNT(*) FROM post_saves sv WHERE sv.post_id = p.id)
+FROM posts p
+WHERE NOT EXISTS (SELECT 1 FROM post_counters pc WHERE pc.post_id = p.id)
+ON CONFLICT (post_id) DO NOTHING;`
+
+// post_engagements хранит по одному сигналу вовлечённости на показ поста пользователю.
+const createEngagementsTable = `
+CREATE TABLE IF NOT EXISTS post_engagements (
+    post_id         BIGINT NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    user_id         BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    dwell_ms        BIGINT NOT NULL CHECK (dwell_ms >= 0),
+    skipped         BOOLEAN NOT NULL,
+    comments_opened BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (post_id, user_id)
+);`
+
+// comment_pseudonyms раздаёт комментаторам поста номера по порядку первого комментария;
+// по номеру строится псевдоним, поэтому в одном обсуждении псевдонимы не повторяются.
+const createCommentPseudonymsTable = `
+CREATE TABLE IF NOT EXISTS comment_pseudonyms (
+    post_id BIGINT NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    idx     INT NOT NULL,
+    PRIMARY KEY (post_id, user_id),
+    UNIQUE (post_id, idx)
+);`
+
+const backfillCommentPseudonyms = `
+INSERT INTO comment_pseudonyms (post_id, user_id, idx)
+SELECT post_id, user_id, ROW_NUMBER() OVER (PARTITION BY post_id ORDER BY MIN(created_at), user_id) - 1
+FROM post_comments
+WHERE NOT EXISTS (SELECT 1 FROM comment_pseudonyms cp WHERE cp.post_id = post_comments.post_id)
+GROUP BY post_id, user_id
+ON CONFLICT DO NOTHING;`
+
 // RunMigrations применяет минимальный набор миграций для interaction-service.
 func RunMigrations(ctx context.Context, pool *pgxpool.Pool) error {
 	stmts := []string{
 		createVotesTable,
 		createCommentsTable,
 		createViewsTable,
+		createCommentsPostIndex,
+		createPollVotersTable,
+		createPollVotesTable,
+		createPollVotesUserIndex,
+		addCommentEditedAtColumn,
+		addCommentParentColumns,
+		createCommentsParentIndex,
+		createReactionsTable,
+		createReactionsPostIndex,
+		createCommentVotesTable,
+		createCommentMilestonesTable,
+		createCommentFlagsTable,
+		createCommentFlagsIndex,
+		createSavesTable,
+		createSavesUserIndex,
+		createSavesPostIndex,
+		createCountersTable,
+		backfillCounters,
+		createEngagementsTable,
+		createCommentPseudonymsTable,
+		backfillCommentPseudonyms,
 	}
 
 	for _, stmt := range stmts {
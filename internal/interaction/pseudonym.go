@@ -0,0 +1,70 @@
+package interaction
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"strconv"
+)
+
+// opPseudonym — псевдоним автора поста в комментариях к своему посту.
+const opPseudonym = "OP"
+
+// fallbackPseudonym показывается, если у комментатора почему-то нет номера в обсуждении.
+const fallbackPseudonym = "Аноним"
+
+var pseudonymAdjectives = []string{
+	"Тихий", "Серый", "Ночной", "Быстрый", "Хитрый", "Сонный", "Смелый", "Рыжий",
+	"Туманный", "Лунный", "Дикий", "Мудрый", "Весёлый", "Грустный", "Северный", "Южный",
+	"Пёстрый", "Ловкий", "Угрюмый", "Добрый", "Звёздный", "Морской", "Лесной", "Снежный",
+	"Медный", "Ржавый", "Бледный", "Шустрый", "Упрямый", "Гордый", "Робкий", "Странный",
+}
+
+var pseudonymAnimals = []string{
+	"Ёж", "Лис", "Волк", "Барсук", "Филин", "Ворон", "Кот", "Енот",
+	"Заяц", "Медведь", "Бобр", "Хорёк", "Тюлень", "Пингвин", "Лось", "Олень",
+	"Сокол", "Дрозд", "Крот", "Суслик", "Хомяк", "Тигр", "Пёс", "Краб",
+	"Кит", "Дельфин", "Орёл", "Журавль", "Удав", "Жук", "Сом", "Окунь",
+}
+
+// pseudonym выводит имя комментатора из его номера index в обсуждении поста. Секрет сервера
+// задаёт для каждого поста свою перестановку имён, так что первые комментаторы поста получают
+// разные имена, а при исчерпании имён к ним добавляется номер круга. Номера раздаются по порядку
+// первого комментария, поэтому без секрета имя нельзя сопоставить с пользователем.
+func pseudonym(secret []byte, postID int64, index int32, isOP bool) string {
+	if isOP {
+		return opPseudonym
+	}
+	if index < 0 {
+		return fallbackPseudonym
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(postID))
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// шаг, взаимно простой с числом имён, обходит все имена до первого повтора
+	total := uint64(len(pseudonymAdjectives) * len(pseudonymAnimals))
+	start := binary.BigEndian.Uint64(sum[0:8]) % total
+	step := binary.BigEndian.Uint64(sum[8:16])%total | 1
+	for gcd(step, total) != 1 {
+		step += 2
+	}
+
+	slot := (start + uint64(index)%total*step) % total
+	name := pseudonymAdjectives[slot/uint64(len(pseudonymAnimals))] + " " + pseudonymAnimals[slot%uint64(len(pseudonymAnimals))]
+	if round := uint64(index) / total; round > 0 {
+		name += " " + strconv.FormatUint(round+1, 10)
+	}
+	return name
+}
+
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
+
+	"ghostnet/internal/common/cursor"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -12,8 +15,35 @@ import (
 var (
 	// ErrPostNotFound используется, когда пост отсутствует.
 	ErrPostNotFound = errors.New("post not found")
+	// ErrPollNotFound возвращается, когда пост не является опросом.
+	ErrPollNotFound = errors.New("poll not found")
+	// ErrAlreadyVoted возвращается при повторном голосе в опросе с одним вариантом.
+	ErrAlreadyVoted = errors.New("already voted")
+	// ErrInvalidPollOption возвращается, когда вариант не принадлежит опросу.
+	ErrInvalidPollOption = errors.New("invalid poll option")
+	// ErrCommentNotFound возвращается, когда комментарий отсутствует или удалён.
+	ErrCommentNotFound = errors.New("comment not found")
+	// ErrNotCommentAuthor возвращается, когда у пользователя нет прав на комментарий.
+	ErrNotCommentAuthor = errors.New("not comment author")
+)
+
+// Области теневого бана, скрывающие действия пользователя от остальных: голоса и реакции
+// скрывает только бан на всё, комментарии — ещё и бан на комментирование.
+const (
+	voteBanScopes    = `'all'`
+	commentBanScopes = `'commenting', 'all'`
 )
 
+// shadowBanned возвращает условие «у пользователя userCol действует теневой бан с областью из scopes».
+// Действия таких пользователей сохраняются, но не попадают ни в счётчики, ни в выдачу другим.
+func shadowBanned(userCol, scopes string) string {
+	return `EXISTS (
+		SELECT 1 FROM user_bans b
+		WHERE b.user_id = ` + userCol + ` AND b.shadow AND b.revoked_at IS NULL
+		  AND (b.expires_at IS NULL OR b.expires_at > NOW())
+		  AND b.scope IN (` + scopes + `))`
+}
+
 type Repository struct {
 	pool *pgxpool.Pool
 }
@@ -34,35 +64,356 @@ func (r *Repository) GetPostAuthor(ctx context.Context, postID int64) (int64, er
 	return authorID, nil
 }
 
-func (r *Repository) UpsertVote(ctx context.Context, userID, postID int64, voteType int16) error {
-	_, err := r.pool.Exec(ctx, `
+// SetVote ставит голос пользователя и возвращает предыдущий: 0 — голоса не было.
+// Повтор того же голоса строку не меняет и возвращает previous == voteType.
+// Голос с counted == false (теневой бан) сохраняется, но в post_counters не попадает.
+func (r *Repository) SetVote(ctx context.Context, userID, postID int64, voteType int16, counted bool) (int16, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// голос бывает только 1 или -1, поэтому по факту вставки/обновления предыдущий восстанавливается однозначно
+	var inserted bool
+	err = tx.QueryRow(ctx, `
 		INSERT INTO post_votes (post_id, user_id, vote_type, updated_at)
 		VALUES ($1, $2, $3, NOW())
 		ON CONFLICT (post_id, user_id) DO UPDATE
 		SET vote_type = EXCLUDED.vote_type,
 		    updated_at = NOW()
-	`, postID, userID, voteType)
+		WHERE post_votes.vote_type <> EXCLUDED.vote_type
+		RETURNING xmax = 0
+	`, postID, userID, voteType).Scan(&inserted)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return voteType, nil
+	}
 	if err != nil {
-		return fmt.Errorf("upsert vote: %w", err)
+		return 0, fmt.Errorf("set vote: %w", err)
 	}
-	return nil
+
+	var previous int16
+	if !inserted {
+		previous = -voteType
+	}
+	if counted {
+		if err := bumpCounters(ctx, tx, postID, voteDelta(previous, voteType)); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("commit: %w", err)
+	}
+	return previous, nil
+}
+
+// RemoveVote снимает голос и возвращает снятый: 0 — голоса не было. counted == false значит,
+// что пользователь под теневым баном и его голос не учитывался в post_counters.
+func (r *Repository) RemoveVote(ctx context.Context, userID, postID int64) (int16, bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var previous int16
+	var counted bool
+	err = tx.QueryRow(ctx, `
+		DELETE FROM post_votes
+		WHERE post_id = $1 AND user_id = $2
+		RETURNING vote_type, NOT `+shadowBanned("$2", voteBanScopes)+`
+	`, postID, userID).Scan(&previous, &counted)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("remove vote: %w", err)
+	}
+
+	if counted {
+		if err := bumpCounters(ctx, tx, postID, voteDelta(previous, 0)); err != nil {
+			return 0, false, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, false, fmt.Errorf("commit: %w", err)
+	}
+	return previous, counted, nil
+}
+
+// NewComment — комментарий к посту или ответ на ParentID.
+type NewComment struct {
+	UserID   int64
+	PostID   int64
+	ParentID int64
+	Depth    int16
+	Text     string
+	// Flags записываются в comment_flags; модерация ставит их в очередь системными жалобами.
+	Flags []Flag
+	// Shadow — автор под теневым баном: комментарий не учитывается в post_counters.
+	Shadow bool
+}
+
+// Flag — автоматическая отметка комментария для модерации.
+type Flag struct {
+	Reason  string
+	Details string
+}
+
+// SetReaction ставит реакцию пользователя (пустая строка снимает её) и возвращает предыдущую.
+func (r *Repository) SetReaction(ctx context.Context, userID, postID int64, reaction string) (string, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return "", fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var previous string
+	err = tx.QueryRow(ctx, `
+		SELECT reaction FROM post_reactions
+		WHERE post_id = $1 AND user_id = $2
+		FOR UPDATE
+	`, postID, userID).Scan(&previous)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("get reaction: %w", err)
+	}
+	if previous == reaction {
+		return previous, nil
+	}
+
+	if reaction == "" {
+		_, err = tx.Exec(ctx, `DELETE FROM post_reactions WHERE post_id = $1 AND user_id = $2`, postID, userID)
+	} else {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO post_reactions (post_id, user_id, reaction)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (post_id, user_id) DO UPDATE
+			SET reaction = EXCLUDED.reaction,
+			    updated_at = NOW()
+		`, postID, userID, reaction)
+	}
+	if err != nil {
+		return "", fmt.Errorf("set reaction: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("commit: %w", err)
+	}
+	return previous, nil
 }
 
-func (r *Repository) AddComment(ctx context.Context, userID, postID int64, text string) (int64, error) {
+func (r *Repository) AddComment(ctx context.Context, c NewComment) (int64, error) {
+	var parentID *int64
+	if c.ParentID != 0 {
+		parentID = &c.ParentID
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
 	var id int64
-	err := r.pool.QueryRow(ctx, `
-		INSERT INTO post_comments (post_id, user_id, text)
-		VALUES ($1, $2, $3)
+	err = tx.QueryRow(ctx, `
+		INSERT INTO post_comments (post_id, user_id, text, parent_comment_id, depth)
+		VALUES ($1, $2, $3, $4, $5)
 		RETURNING id
-	`, postID, userID, text).Scan(&id)
+	`, c.PostID, c.UserID, c.Text, parentID, c.Depth).Scan(&id)
 	if err != nil {
 		return 0, fmt.Errorf("insert comment: %w", err)
 	}
+
+	if err := insertCommentFlags(ctx, tx, id, c.Flags); err != nil {
+		return 0, err
+	}
+
+	if !c.Shadow {
+		if err := bumpCounters(ctx, tx, c.PostID, Counters{Comments: 1}); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := assignPseudonym(ctx, tx, c.PostID, c.UserID); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("commit: %w", err)
+	}
 	return id, nil
 }
 
+const pseudonymAssignAttempts = 5
+
+// assignPseudonym выдаёт новому комментатору поста следующий номер. Если параллельный
+// комментатор занял тот же номер, ON CONFLICT дожидается его транзакции, а следующая
+// попытка уже видит занятый номер.
+func assignPseudonym(ctx context.Context, tx pgx.Tx, postID, userID int64) error {
+	for attempt := 0; attempt < pseudonymAssignAttempts; attempt++ {
+		var exists bool
+		if err := tx.QueryRow(ctx, `
+			SELECT EXISTS (SELECT 1 FROM comment_pseudonyms WHERE post_id = $1 AND user_id = $2)
+		`, postID, userID).Scan(&exists); err != nil {
+			return fmt.Errorf("check pseudonym: %w", err)
+		}
+		if exists {
+			return nil
+		}
+
+		tag, err := tx.Exec(ctx, `
+			INSERT INTO comment_pseudonyms (post_id, user_id, idx)
+			SELECT $1, $2, COALESCE(MAX(idx) + 1, 0) FROM comment_pseudonyms WHERE post_id = $1
+			ON CONFLICT DO NOTHING
+		`, postID, userID)
+		if err != nil {
+			return fmt.Errorf("assign pseudonym: %w", err)
+		}
+		if tag.RowsAffected() > 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("assign pseudonym: no free index after %d attempts", pseudonymAssignAttempts)
+}
+
+// Comment — комментарий вместе с автором поста, к которому он оставлен.
+type Comment struct {
+	ID           int64
+	PostID       int64
+	UserID       int64
+	PostAuthorID int64
+	Depth        int16
+}
+
+// GetComment возвращает неудалённый комментарий к неудалённому посту.
+func (r *Repository) GetComment(ctx context.Context, commentID int64) (Comment, error) {
+	var c Comment
+	err := r.pool.QueryRow(ctx, `
+		SELECT c.id, c.post_id, c.user_id, p.author_user_id, c.depth
+		FROM post_comments c
+		JOIN posts p ON p.id = c.post_id
+		WHERE c.id = $1 AND c.is_deleted = FALSE AND p.is_deleted = FALSE
+	`, commentID).Scan(&c.ID, &c.PostID, &c.UserID, &c.PostAuthorID, &c.Depth)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return c, ErrCommentNotFound
+	}
+	if err != nil {
+		return c, fmt.Errorf("get comment: %w", err)
+	}
+	return c, nil
+}
+
+// lockComment блокирует неудалённый комментарий к неудалённому посту до конца транзакции.
+func lockComment(ctx context.Context, tx pgx.Tx, commentID int64) (Comment, error) {
+	var c Comment
+	err := tx.QueryRow(ctx, `
+		SELECT c.id, c.post_id, c.user_id, p.author_user_id, c.depth
+		FROM post_comments c
+		JOIN posts p ON p.id = c.post_id
+		WHERE c.id = $1 AND c.is_deleted = FALSE AND p.is_deleted = FALSE
+		FOR UPDATE OF c
+	`, commentID).Scan(&c.ID, &c.PostID, &c.UserID, &c.PostAuthorID, &c.Depth)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return c, ErrCommentNotFound
+	}
+	if err != nil {
+		return c, fmt.Errorf("lock comment: %w", err)
+	}
+	return c, nil
+}
+
+// EditComment меняет текст комментария и записывает отметки фильтра; редактировать может только его автор.
+func (r *Repository) EditComment(ctx context.Context, userID, commentID int64, text string, flags []Flag) (Comment, time.Time, error) {
+	var editedAt time.Time
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return Comment{}, editedAt, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	c, err := lockComment(ctx, tx, commentID)
+	if err != nil {
+		return c, editedAt, err
+	}
+	if c.UserID != userID {
+		return c, editedAt, ErrNotCommentAuthor
+	}
+
+	if err := tx.QueryRow(ctx, `
+		UPDATE post_comments SET text = $2, edited_at = NOW()
+		WHERE id = $1
+		RETURNING edited_at
+	`, commentID, text).Scan(&editedAt); err != nil {
+		return c, editedAt, fmt.Errorf("update comment: %w", err)
+	}
+
+	if err := insertCommentFlags(ctx, tx, commentID, flags); err != nil {
+		return c, editedAt, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return c, editedAt, fmt.Errorf("commit: %w", err)
+	}
+	return c, editedAt, nil
+}
+
+func insertCommentFlags(ctx context.Context, tx pgx.Tx, commentID int64, flags []Flag) error {
+	for _, f := range flags {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO comment_flags (comment_id, reason, details) VALUES ($1, $2, $3)
+		`, commentID, f.Reason, f.Details); err != nil {
+			return fmt.Errorf("insert comment flag: %w", err)
+		}
+	}
+	return nil
+}
+
+// DeleteComment помечает комментарий удалённым; удалить может автор комментария или автор поста.
+func (r *Repository) DeleteComment(ctx context.Context, userID, commentID int64) (Comment, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return Comment{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	c, err := lockComment(ctx, tx, commentID)
+	if err != nil {
+		return c, err
+	}
+	if c.UserID != userID && c.PostAuthorID != userID {
+		return c, ErrNotCommentAuthor
+	}
+
+	var counted bool
+	if err := tx.QueryRow(ctx, `
+		UPDATE post_comments SET is_deleted = TRUE WHERE id = $1
+		RETURNING NOT `+shadowBanned("post_comments.user_id", commentBanScopes)+`
+	`, commentID).Scan(&counted); err != nil {
+		return c, fmt.Errorf("delete comment: %w", err)
+	}
+	// lockComment вернул только неудалённый комментарий, так что счётчик уменьшается ровно один раз;
+	// комментарии автора под теневым баном в счётчике не учитывались
+	if counted {
+		if err := bumpCounters(ctx, tx, c.PostID, Counters{Comments: -1}); err != nil {
+			return c, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return c, fmt.Errorf("commit: %w", err)
+	}
+	return c, nil
+}
+
 func (r *Repository) MarkViewed(ctx context.Context, userID, postID int64) (bool, error) {
-	tag, err := r.pool.Exec(ctx, `
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
 		INSERT INTO post_views (post_id, user_id)
 		VALUES ($1, $2)
 		ON CONFLICT DO NOTHING
@@ -70,83 +421,755 @@ func (r *Repository) MarkViewed(ctx context.Context, userID, postID int64) (bool
 	if err != nil {
 		return false, fmt.Errorf("insert view: %w", err)
 	}
-	return tag.RowsAffected() > 0, nil
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if err := bumpCounters(ctx, tx, postID, Counters{Views: 1}); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("commit: %w", err)
+	}
+	return true, nil
 }
 
-func (r *Repository) GetStats(ctx context.Context, postID int64) (likes, dislikes, comments, views int64, err error) {
-	err = r.pool.QueryRow(ctx, `
-		WITH votes AS (
+// GetStats возвращает счётчики поста из post_counters. С includeReposts голоса, комментарии
+// и просмотры репостов суммируются в оригинал; голоса и просмотры при этом считаются
+// по уникальным пользователям, поэтому берутся из исходных таблиц, а не из post_counters.
+func (r *Repository) GetStats(ctx context.Context, postID int64, includeReposts bool) (PostStats, error) {
+	var st PostStats
+	var err error
+	if includeReposts {
+		err = r.scanStatsWithReposts(ctx, postID, &st)
+	} else {
+		err = r.pool.QueryRow(ctx, `
+			SELECT COALESCE(pc.likes, 0),
+			       COALESCE(pc.dislikes, 0),
+			       COALESCE(pc.comments, 0),
+			       COALESCE(pc.views, 0),
+			       (SELECT COUNT(*) FROM posts rp WHERE rp.repost_of_post_id = $1 AND rp.is_deleted = FALSE),
+			       COALESCE(pc.saves, 0)
+			FROM (SELECT $1::bigint AS post_id) p
+			LEFT JOIN post_counters pc ON pc.post_id = p.post_id
+		`, postID).Scan(&st.Likes, &st.Dislikes, &st.Comments, &st.Views, &st.Reposts, &st.Saves)
+	}
+	if err != nil {
+		return st, fmt.Errorf("get stats: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT reaction, COUNT(*)
+		FROM post_reactions
+		WHERE (post_id = $1
+		       OR ($2 AND post_id IN (SELECT id FROM posts WHERE repost_of_post_id = $1 AND is_deleted = FALSE)))
+		  AND NOT `+shadowBanned("post_reactions.user_id", voteBanScopes)+`
+		GROUP BY reaction
+	`, postID, includeReposts)
+	if err != nil {
+		return st, fmt.Errorf("get reactions: %w", err)
+	}
+	defer rows.Close()
+
+	st.Reactions = make(map[string]int64)
+	for rows.Next() {
+		var reaction string
+		var count int64
+		if err := rows.Scan(&reaction, &count); err != nil {
+			return st, fmt.Errorf("scan reaction: %w", err)
+		}
+		st.Reactions[reaction] = count
+	}
+	if err := rows.Err(); err != nil {
+		return st, fmt.Errorf("get reactions: %w", err)
+	}
+	return st, nil
+}
+
+// scanStatsWithReposts считает счётчики оригинала вместе с репостами по исходным таблицам.
+func (r *Repository) scanStatsWithReposts(ctx context.Context, postID int64, st *PostStats) error {
+	return r.pool.QueryRow(ctx, `
+		WITH targets AS (
+			SELECT $1::bigint AS post_id
+			UNION
+			SELECT id FROM posts
+			WHERE repost_of_post_id = $1 AND is_deleted = FALSE
+		),
+		votes AS (
 			SELECT
-				COUNT(*) FILTER (WHERE vote_type = 1)  AS likes,
-				COUNT(*) FILTER (WHERE vote_type = -1) AS dislikes
+				COUNT(DISTINCT user_id) FILTER (WHERE vote_type = 1)  AS likes,
+				COUNT(DISTINCT user_id) FILTER (WHERE vote_type = -1) AS dislikes
 			FROM post_votes
-			WHERE post_id = $1
+			WHERE post_id IN (SELECT post_id FROM targets)
+			  AND NOT `+shadowBanned("post_votes.user_id", voteBanScopes)+`
 		),
 		comments AS (
 			SELECT COUNT(*) AS comments
 			FROM post_comments
-			WHERE post_id = $1 AND is_deleted = FALSE
+			WHERE post_id IN (SELECT post_id FROM targets) AND is_deleted = FALSE
+			  AND NOT `+shadowBanned("post_comments.user_id", commentBanScopes)+`
 		),
 		views AS (
-			SELECT COUNT(*) AS views
+			SELECT COUNT(DISTINCT user_id) AS views
 			FROM post_views
-			WHERE post_id = $1
+			WHERE post_id IN (SELECT post_id FROM targets)
+		),
+		reposts AS (
+			SELECT COUNT(*) AS reposts
+			FROM posts
+			WHERE repost_of_post_id = $1 AND is_deleted = FALSE
+		),
+		saves AS (
+			SELECT COUNT(DISTINCT user_id) AS saves
+			FROM post_saves
+			WHERE post_id IN (SELECT post_id FROM targets)
 		)
 		SELECT
 			COALESCE(v.likes, 0),
 			COALESCE(v.dislikes, 0),
 			COALESCE(c.comments, 0),
-			COALESCE(vw.views, 0)
-		FROM votes v, comments c, views vw
-	`, postID).Scan(&likes, &dislikes, &comments, &views)
+			COALESCE(vw.views, 0),
+			COALESCE(rp.reposts, 0),
+			COALESCE(sv.saves, 0)
+		FROM votes v, comments c, views vw, reposts rp, saves sv
+	`, postID).Scan(&st.Likes, &st.Dislikes, &st.Comments, &st.Views, &st.Reposts, &st.Saves)
+}
+
+// PostStats — счётчики одного поста.
+type PostStats struct {
+	Likes    int64
+	Dislikes int64
+	Comments int64
+	Views    int64
+	Reposts  int64
+	Saves    int64
+	// Reactions — число реакций по эмодзи; пустых реакций в карте нет.
+	Reactions map[string]int64
+}
+
+// BatchGetStats возвращает счётчики для набора живых постов одним запросом.
+func (r *Repository) BatchGetStats(ctx context.Context, postIDs []int64) (map[int64]PostStats, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT p.id,
+		       COALESCE(pc.likes, 0),
+		       COALESCE(pc.dislikes, 0),
+		       COALESCE(pc.comments, 0),
+		       COALESCE(pc.views, 0),
+		       (SELECT COUNT(*) FROM posts rp WHERE rp.repost_of_post_id = p.id AND rp.is_deleted = FALSE),
+		       COALESCE(pc.saves, 0)
+		FROM posts p
+		LEFT JOIN post_counters pc ON pc.post_id = p.id
+		WHERE p.id = ANY($1) AND p.is_deleted = FALSE
+	`, postIDs)
 	if err != nil {
-		return 0, 0, 0, 0, fmt.Errorf("get stats: %w", err)
+		return nil, fmt.Errorf("batch get stats: %w", err)
 	}
-	return
+	defer rows.Close()
+
+	stats := make(map[int64]PostStats, len(postIDs))
+	for rows.Next() {
+		var id int64
+		var st PostStats
+		if err := rows.Scan(&id, &st.Likes, &st.Dislikes, &st.Comments, &st.Views, &st.Reposts, &st.Saves); err != nil {
+			return nil, fmt.Errorf("scan stats: %w", err)
+		}
+		stats[id] = st
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate stats: %w", err)
+	}
+
+	rows, err = r.pool.Query(ctx, `
+		SELECT post_id, reaction, COUNT(*)
+		FROM post_reactions
+		WHERE post_id = ANY($1) AND NOT `+shadowBanned("post_reactions.user_id", voteBanScopes)+`
+		GROUP BY post_id, reaction
+	`, postIDs)
+	if err != nil {
+		return nil, fmt.Errorf("batch get reactions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, count int64
+		var reaction string
+		if err := rows.Scan(&id, &reaction, &count); err != nil {
+			return nil, fmt.Errorf("scan reaction: %w", err)
+		}
+		st, ok := stats[id]
+		if !ok {
+			continue
+		}
+		if st.Reactions == nil {
+			st.Reactions = make(map[string]int64)
+		}
+		st.Reactions[reaction] = count
+		stats[id] = st
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate reactions: %w", err)
+	}
+	return stats, nil
 }
 
 type CommentItem struct {
 	ID        int64
+	UserID    int64
+	ParentID  int64
+	Depth     int16
 	Text      string
-	CreatedAt string
+	CreatedAt time.Time
+	EditedAt  *time.Time
+	Likes     int64
+	Dislikes  int64
+	// PseudonymIndex — номер автора в обсуждении поста; -1, если номера нет.
+	PseudonymIndex int32
+	// Deleted и Replies заполняются только в дереве: удалённый комментарий
+	// остаётся без текста, пока на него есть ответы.
+	Deleted bool
+	Replies []*CommentItem
+}
+
+// CommentSort — порядок выдачи комментариев.
+type CommentSort int
+
+const (
+	CommentSortNew CommentSort = iota
+	CommentSortOld
+	CommentSortTop
+	CommentSortControversial
+)
+
+// Keyset сообщает, листается ли сортировка по ключу (created_at, id); остальные листаются смещением.
+func (s CommentSort) Keyset() bool {
+	return s == CommentSortNew || s == CommentSortOld
 }
 
-func (r *Repository) ListComments(ctx context.Context, postID int64, page, pageSize int32) ([]CommentItem, bool, error) {
-	if page < 1 {
-		page = 1
+// CommentQuery — параметры страницы комментариев. After учитывается только
+// для сортировок по времени и отменяет Offset.
+type CommentQuery struct {
+	Sort   CommentSort
+	Limit  int32
+	Offset int32
+	After  *cursor.Cursor
+	// Viewer видит свои комментарии, даже если сам под теневым баном.
+	Viewer int64
+}
+
+// commentVotesJoin подтягивает к комментарию c число лайков и дизлайков.
+var commentVotesJoin = `
+		LEFT JOIN LATERAL (
+			SELECT COUNT(*) FILTER (WHERE vote_type = 1)  AS likes,
+			       COUNT(*) FILTER (WHERE vote_type = -1) AS dislikes
+			FROM comment_votes
+			WHERE comment_id = c.id AND NOT ` + shadowBanned("comment_votes.user_id", voteBanScopes) + `
+		) v ON TRUE`
+
+// pseudonymJoin подтягивает к комментарию c номер его автора в обсуждении поста.
+const pseudonymJoin = `
+		LEFT JOIN comment_pseudonyms cp ON cp.post_id = c.post_id AND cp.user_id = c.user_id`
+
+// visibleTo возвращает условие «комментарий alias виден пользователю из параметра viewer»:
+// комментарии авторов под теневым баном видят только они сами.
+func visibleTo(alias, viewer string) string {
+	return `(` + alias + `.user_id = ` + viewer + ` OR NOT ` + shadowBanned(alias+".user_id", commentBanScopes) + `)`
+}
+
+// controversyExpr растёт с числом голосов и тем сильнее, чем ближе лайки к дизлайкам.
+const controversyExpr = `CASE WHEN v.likes > 0 AND v.dislikes > 0
+		THEN POWER(v.likes + v.dislikes, LEAST(v.likes, v.dislikes)::float8 / GREATEST(v.likes, v.dislikes))
+		ELSE 0 END`
+
+// commentOrder возвращает условие продолжения после ключа ($4, $5) и ORDER BY для сортировки.
+// Для сортировок по оценкам ключ всегда пуст, и условие ничего не отсекает.
+func commentOrder(sort CommentSort) (string, string) {
+	const before = "($4::timestamptz IS NULL OR (c.created_at, c.id) < ($4, $5))"
+	switch sort {
+	case CommentSortOld:
+		return "($4::timestamptz IS NULL OR (c.created_at, c.id) > ($4, $5))", "c.created_at, c.id"
+	case CommentSortTop:
+		return before, "v.likes - v.dislikes DESC, c.created_at DESC, c.id DESC"
+	case CommentSortControversial:
+		return before, controversyExpr + " DESC, c.created_at DESC, c.id DESC"
+	default:
+		return before, "c.created_at DESC, c.id DESC"
 	}
-	if pageSize <= 0 {
-		pageSize = 10
+}
+
+// pageArgs раскладывает CommentQuery в параметры $2..$5 запросов списка комментариев.
+func (q CommentQuery) pageArgs() (int32, int32, *time.Time, int64) {
+	if q.Limit <= 0 {
+		q.Limit = 10
+	}
+	if q.After != nil && q.Sort.Keyset() {
+		return q.Limit + 1, 0, &q.After.CreatedAt, q.After.ID
 	}
-	offset := (page - 1) * pageSize
-	limit := pageSize + 1
+	return q.Limit + 1, q.Offset, nil, 0
+}
+
+// ListComments возвращает комментарии и ответы поста одним списком в порядке q.Sort.
+func (r *Repository) ListComments(ctx context.Context, postID int64, q CommentQuery) ([]CommentItem, bool, error) {
+	limit, offset, afterTime, afterID := q.pageArgs()
+	after, orderBy := commentOrder(q.Sort)
 
 	rows, err := r.pool.Query(ctx, `
-		SELECT id, text, created_at
-		FROM post_comments
-		WHERE post_id = $1 AND is_deleted = FALSE
-		ORDER BY created_at DESC
+		SELECT c.id, c.user_id, COALESCE(c.parent_comment_id, 0), c.depth, c.text, c.created_at, c.edited_at,
+		       v.likes, v.dislikes, COALESCE(cp.idx, -1)
+		FROM post_comments c`+commentVotesJoin+pseudonymJoin+`
+		WHERE c.post_id = $1 AND c.is_deleted = FALSE AND `+visibleTo("c", "$6")+` AND `+after+`
+		ORDER BY `+orderBy+`
 		LIMIT $2 OFFSET $3
-	`, postID, limit, offset)
+	`, postID, limit, offset, afterTime, afterID, q.Viewer)
 	if err != nil {
 		return nil, false, fmt.Errorf("list comments: %w", err)
 	}
 	defer rows.Close()
 
-	items := make([]CommentItem, 0, pageSize)
+	items := make([]CommentItem, 0, limit)
 	for rows.Next() {
 		var item CommentItem
-		if err := rows.Scan(&item.ID, &item.Text, &item.CreatedAt); err != nil {
+		if err := rows.Scan(&item.ID, &item.UserID, &item.ParentID, &item.Depth, &item.Text, &item.CreatedAt, &item.EditedAt,
+			&item.Likes, &item.Dislikes, &item.PseudonymIndex); err != nil {
 			return nil, false, fmt.Errorf("scan comment: %w", err)
 		}
 		items = append(items, item)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("list comments: %w", err)
+	}
+
+	hasMore := false
+	if int32(len(items)) >= limit {
+		hasMore = true
+		items = items[:limit-1]
+	}
+
+	return items, hasMore, nil
+}
+
+// ListCommentThreads возвращает страницу корневых комментариев в порядке q.Sort
+// с деревом ответов под каждым. Ответы внутри ветки идут от старых к новым.
+func (r *Repository) ListCommentThreads(ctx context.Context, postID int64, q CommentQuery) ([]*CommentItem, bool, error) {
+	limit, offset, afterTime, afterID := q.pageArgs()
+	after, orderBy := commentOrder(q.Sort)
+
+	// удалённый корень попадает в выдачу, только если в его ветке, на любой глубине,
+	// остался живой ответ, видимый зрителю; ветка обходится так же, как при сборке дерева ниже
+	rows, err := r.pool.Query(ctx, `
+		SELECT c.id, c.user_id, c.text, c.created_at, c.edited_at, c.is_deleted, v.likes, v.dislikes, COALESCE(cp.idx, -1)
+		FROM post_comments c`+commentVotesJoin+pseudonymJoin+`
+		WHERE c.post_id = $1 AND c.parent_comment_id IS NULL
+		  AND (c.is_deleted = FALSE OR EXISTS (
+		      WITH RECURSIVE branch AS (
+		          SELECT r.id, r.is_deleted FROM post_comments r
+		          WHERE r.parent_comment_id = c.id AND `+visibleTo("r", "$6")+`
+		          UNION ALL
+		          SELECT r.id, r.is_deleted FROM post_comments r
+		          JOIN branch br ON r.parent_comment_id = br.id
+		          WHERE `+visibleTo("r", "$6")+`
+		      )
+		      SELECT 1 FROM branch WHERE NOT branch.is_deleted))
+		  AND `+visibleTo("c", "$6")+`
+		  AND `+after+`
+		ORDER BY `+orderBy+`
+		LIMIT $2 OFFSET $3
+	`, postID, limit, offset, afterTime, afterID, q.Viewer)
+	if err != nil {
+		return nil, false, fmt.Errorf("list root comments: %w", err)
+	}
+	defer rows.Close()
+
+	roots := make([]*CommentItem, 0, limit)
+	for rows.Next() {
+		item := &CommentItem{}
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Text, &item.CreatedAt, &item.EditedAt, &item.Deleted,
+			&item.Likes, &item.Dislikes, &item.PseudonymIndex); err != nil {
+			return nil, false, fmt.Errorf("scan comment: %w", err)
+		}
+		roots = append(roots, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("list root comments: %w", err)
+	}
 
 	hasMore := false
-	if int32(len(items)) > pageSize {
+	if int32(len(roots)) >= limit {
 		hasMore = true
-		items = items[:pageSize]
+		roots = roots[:limit-1]
+	}
+	if len(roots) == 0 {
+		return roots, hasMore, nil
+	}
+
+	byID := make(map[int64]*CommentItem, len(roots))
+	rootIDs := make([]int64, 0, len(roots))
+	for _, root := range roots {
+		byID[root.ID] = root
+		rootIDs = append(rootIDs, root.ID)
+	}
+
+	rows, err = r.pool.Query(ctx, `
+		WITH RECURSIVE thread AS (
+			SELECT c.id, c.post_id, c.user_id, c.parent_comment_id, c.depth, c.text, c.created_at, c.edited_at, c.is_deleted
+			FROM post_comments c
+			WHERE c.parent_comment_id = ANY($1) AND `+visibleTo("c", "$2")+`
+			UNION ALL
+			SELECT c.id, c.post_id, c.user_id, c.parent_comment_id, c.depth, c.text, c.created_at, c.edited_at, c.is_deleted
+			FROM post_comments c
+			JOIN thread t ON c.parent_comment_id = t.id
+			WHERE `+visibleTo("c", "$2")+`
+		)
+		SELECT c.id, c.user_id, c.parent_comment_id, c.depth, c.text, c.created_at, c.edited_at, c.is_deleted,
+		       v.likes, v.dislikes, COALESCE(cp.idx, -1)
+		FROM thread c`+commentVotesJoin+pseudonymJoin+`
+		ORDER BY c.created_at, c.id
+	`, rootIDs, q.Viewer)
+	if err != nil {
+		return nil, false, fmt.Errorf("list replies: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item := &CommentItem{}
+		if err := rows.Scan(&item.ID, &item.UserID, &item.ParentID, &item.Depth, &item.Text, &item.CreatedAt, &item.EditedAt, &item.Deleted,
+			&item.Likes, &item.Dislikes, &item.PseudonymIndex); err != nil {
+			return nil, false, fmt.Errorf("scan reply: %w", err)
+		}
+		// ответ всегда создаётся позже родителя, поэтому родитель уже в byID
+		if parent, ok := byID[item.ParentID]; ok {
+			parent.Replies = append(parent.Replies, item)
+			byID[item.ID] = item
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("list replies: %w", err)
+	}
+
+	return pruneDeleted(roots), hasMore, nil
+}
+
+// pruneDeleted убирает удалённые комментарии без живых ответов и стирает текст остальных удалённых.
+func pruneDeleted(items []*CommentItem) []*CommentItem {
+	out := items[:0]
+	for _, item := range items {
+		item.Replies = pruneDeleted(item.Replies)
+		if item.Deleted {
+			if len(item.Replies) == 0 {
+				continue
+			}
+			item.Text, item.EditedAt = "", nil
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// SetCommentVote ставит голос за комментарий и возвращает предыдущий по тем же правилам, что SetVote.
+func (r *Repository) SetCommentVote(ctx context.Context, userID, commentID int64, voteType int16) (int16, error) {
+	var inserted bool
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO comment_votes (comment_id, user_id, vote_type, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (comment_id, user_id) DO UPDATE
+		SET vote_type = EXCLUDED.vote_type,
+		    updated_at = NOW()
+		WHERE comment_votes.vote_type <> EXCLUDED.vote_type
+		RETURNING xmax = 0
+	`, commentID, userID, voteType).Scan(&inserted)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return voteType, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("set comment vote: %w", err)
+	}
+	if inserted {
+		return 0, nil
+	}
+	return -voteType, nil
+}
+
+// CommentLikes возвращает текущее число учитываемых лайков комментария.
+func (r *Repository) CommentLikes(ctx context.Context, commentID int64) (int64, error) {
+	var likes int64
+	if err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM comment_votes
+		WHERE comment_id = $1 AND vote_type = 1 AND NOT `+shadowBanned("comment_votes.user_id", voteBanScopes)+`
+	`, commentID).Scan(&likes); err != nil {
+		return 0, fmt.Errorf("count comment likes: %w", err)
+	}
+	return likes, nil
+}
+
+// MarkCommentMilestone фиксирует рубеж лайков; false — о нём уже уведомляли.
+func (r *Repository) MarkCommentMilestone(ctx context.Context, commentID, milestone int64) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO comment_like_milestones (comment_id, milestone)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, commentID, milestone)
+	if err != nil {
+		return false, fmt.Errorf("mark comment milestone: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// Poll — опрос с подсчитанными голосами.
+type Poll struct {
+	Question       string
+	MultipleChoice bool
+	TotalVoters    int64
+	Options        []PollOption
+}
+
+// PollOption — вариант ответа и число выбравших его.
+type PollOption struct {
+	ID    int64
+	Text  string
+	Votes int64
+}
+
+// GetPoll загружает опрос живого поста вместе с результатами. Голоса пользователей под
+// теневым баном не считаются, кроме голоса самого viewerID.
+func (r *Repository) GetPoll(ctx context.Context, postID, viewerID int64) (Poll, error) {
+	var poll Poll
+	err := r.pool.QueryRow(ctx, `
+		SELECT p.text,
+		       pl.multiple_choice,
+		       (SELECT COUNT(*) FROM poll_voters pv
+		        WHERE pv.post_id = pl.post_id AND (pv.user_id = $2 OR NOT `+shadowBanned("pv.user_id", voteBanScopes)+`))
+		FROM polls pl
+		JOIN posts p ON p.id = pl.post_id
+		WHERE pl.post_id = $1 AND p.is_deleted = FALSE
+	`, postID, viewerID).Scan(&poll.Question, &poll.MultipleChoice, &poll.TotalVoters)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return poll, ErrPollNotFound
+	}
+	if err != nil {
+		return poll, fmt.Errorf("get poll: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT o.id, o.text, COUNT(v.user_id)
+		FROM poll_options o
+		LEFT JOIN poll_votes v ON v.option_id = o.id
+		    AND (v.user_id = $2 OR NOT `+shadowBanned("v.user_id", voteBanScopes)+`)
+		WHERE o.post_id = $1
+		GROUP BY o.id, o.text, o.position
+		ORDER BY o.position
+	`, postID, viewerID)
+	if err != nil {
+		return poll, fmt.Errorf("get poll options: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var opt PollOption
+		if err := rows.Scan(&opt.ID, &opt.Text, &opt.Votes); err != nil {
+			return poll, fmt.Errorf("scan poll option: %w", err)
+		}
+		poll.Options = append(poll.Options, opt)
+	}
+	if err := rows.Err(); err != nil {
+		return poll, fmt.Errorf("iterate poll options: %w", err)
+	}
+	return poll, nil
+}
+
+// PollChoices возвращает варианты, выбранные пользователем.
+func (r *Repository) PollChoices(ctx context.Context, userID, postID int64) ([]int64, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT option_id FROM poll_votes WHERE post_id = $1 AND user_id = $2
+	`, postID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get poll choices: %w", err)
+	}
+	choices, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return nil, fmt.Errorf("scan poll choices: %w", err)
+	}
+	return choices, nil
+}
+
+// VotePoll записывает голоса пользователя. В опросе с одним вариантом голос окончательный,
+// в опросе с несколькими варианты можно добавлять. Возвращает true, если появился новый голос.
+func (r *Repository) VotePoll(ctx context.Context, userID, postID int64, optionIDs []int64, multipleChoice bool) (bool, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var valid int
+	if err := tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM poll_options WHERE post_id = $1 AND id = ANY($2)
+	`, postID, optionIDs).Scan(&valid); err != nil {
+		return false, fmt.Errorf("check poll options: %w", err)
+	}
+	if valid != len(optionIDs) {
+		return false, ErrInvalidPollOption
 	}
 
+	// строка в poll_voters служит блокировкой: параллельный голос того же пользователя упрётся в PK
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO poll_voters (post_id, user_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, postID, userID)
+	if err != nil {
+		return false, fmt.Errorf("insert poll voter: %w", err)
+	}
+	if tag.RowsAffected() == 0 && !multipleChoice {
+		return false, ErrAlreadyVoted
+	}
+
+	tag, err = tx.Exec(ctx, `
+		INSERT INTO poll_votes (option_id, post_id, user_id)
+		SELECT UNNEST($3::bigint[]), $1, $2
+		ON CONFLICT DO NOTHING
+	`, postID, userID, optionIDs)
+	if err != nil {
+		return false, fmt.Errorf("insert poll votes: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("commit: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Engagement — сигнал вовлечённости одного показа поста.
+type Engagement struct {
+	UserID         int64
+	PostID         int64
+	DwellMs        int64
+	Skipped        bool
+	CommentsOpened bool
+}
+
+// RecordEngagement сохраняет сигнал. Повторный показ того же поста объединяется с прежним:
+// берётся большее время, пропуском показ остаётся, только если пропущен оба раза.
+func (r *Repository) RecordEngagement(ctx context.Context, e Engagement) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO post_engagements (post_id, user_id, dwell_ms, skipped, comments_opened)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (post_id, user_id) DO UPDATE
+		SET dwell_ms        = GREATEST(post_engagements.dwell_ms, EXCLUDED.dwell_ms),
+		    skipped         = post_engagements.skipped AND EXCLUDED.skipped,
+		    comments_opened = post_engagements.comments_opened OR EXCLUDED.comments_opened,
+		    updated_at      = NOW()
+	`, e.PostID, e.UserID, e.DwellMs, e.Skipped, e.CommentsOpened)
+	if err != nil {
+		return fmt.Errorf("record engagement: %w", err)
+	}
+	return nil
+}
+
+// SavePost добавляет пост в закладки пользователя и сообщает, был ли он там раньше.
+func (r *Repository) SavePost(ctx context.Context, userID, postID int64) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO post_saves (user_id, post_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, userID, postID)
+	if err != nil {
+		return false, fmt.Errorf("insert save: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return true, nil
+	}
+
+	if err := bumpCounters(ctx, tx, postID, Counters{Saves: 1}); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("commit: %w", err)
+	}
+	return false, nil
+}
+
+// UnsavePost убирает пост из закладок; false — поста там не было.
+func (r *Repository) UnsavePost(ctx context.Context, userID, postID int64) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `DELETE FROM post_saves WHERE user_id = $1 AND post_id = $2`, userID, postID)
+	if err != nil {
+		return false, fmt.Errorf("delete save: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if err := bumpCounters(ctx, tx, postID, Counters{Saves: -1}); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("commit: %w", err)
+	}
+	return true, nil
+}
+
+// SavedPost — пост из закладок пользователя.
+type SavedPost struct {
+	PostID      int64
+	TextPreview string
+	MediaType   string
+	SavedAt     time.Time
+}
+
+// ListSavedPosts возвращает закладки от недавно сохранённых по ключу (saved_at, post_id).
+// Удалённые и скрытые после сохранения посты пропускаются, но строка закладки остаётся:
+// восстановленный модератором пост снова появится в списке.
+func (r *Repository) ListSavedPosts(ctx context.Context, userID int64, after *cursor.Cursor, limit int32) ([]SavedPost, bool, error) {
+	var afterTime *time.Time
+	var afterID int64
+	if after != nil {
+		afterTime, afterID = &after.CreatedAt, after.ID
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT s.post_id,
+		       LEFT(p.text, 64),
+		       COALESCE(m.media_type, CASE WHEN pl.post_id IS NOT NULL THEN 'poll' END, ''),
+		       s.created_at
+		FROM post_saves s
+		JOIN posts p ON p.id = s.post_id AND p.is_deleted = FALSE
+		LEFT JOIN post_media m ON m.post_id = p.id
+		LEFT JOIN polls pl ON pl.post_id = p.id
+		WHERE s.user_id = $1
+		  AND ($3::timestamptz IS NULL OR (s.created_at, s.post_id) < ($3, $4))
+		ORDER BY s.created_at DESC, s.post_id DESC
+		LIMIT $2
+	`, userID, limit+1, afterTime, afterID)
+	if err != nil {
+		return nil, false, fmt.Errorf("list saved posts: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]SavedPost, 0, limit)
+	for rows.Next() {
+		var item SavedPost
+		if err := rows.Scan(&item.PostID, &item.TextPreview, &item.MediaType, &item.SavedAt); err != nil {
+			return nil, false, fmt.Errorf("scan saved post: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("iterate saved posts: %w", err)
+	}
+
+	hasMore := false
+	if int32(len(items)) > limit {
+		hasMore = true
+		items = items[:limit]
+	}
 	return items, hasMore, nil
 }
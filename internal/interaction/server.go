@@ -9,6 +9,11 @@ import (
 
 	eventsv1 "ghostnet/gen/go/proto/events/v1"
 	interactionv1 "ghostnet/gen/go/proto/interaction/v1"
+	userv1 "ghostnet/gen/go/proto/user/v1"
+	"ghostnet/internal/common/authz"
+	"ghostnet/internal/common/contentfilter"
+	"ghostnet/internal/common/cursor"
+	"ghostnet/internal/common/ids"
 	"ghostnet/internal/common/kafka"
 
 	"go.uber.org/zap"
@@ -19,25 +24,108 @@ import (
 
 const (
 	topicPostEvents = "post-events"
+
+	maxBatchSize = 100
+
+	defaultSavedPageSize = 10
+	maxSavedPageSize     = 50
+
+	// maxCommentDepth — максимальная вложенность ответов; корневой комментарий имеет глубину 0.
+	maxCommentDepth = 5
 )
 
+// Options — настраиваемое поведение InteractionService.
+type Options struct {
+	// PseudonymSecret — ключ HMAC для псевдонимов комментаторов; его смена меняет все псевдонимы.
+	PseudonymSecret string
+	// AllowedReactions — эмодзи, которыми можно реагировать на посты.
+	AllowedReactions []string
+	// ContentFilter проверяет текст комментариев перед сохранением; nil отключает проверку.
+	ContentFilter *contentfilter.Filter
+	// SkipThreshold — показ короче этого без открытия комментариев считается пропуском.
+	SkipThreshold time.Duration
+	// MaxDwell ограничивает учитываемое время показа: пользователь мог просто уйти.
+	MaxDwell time.Duration
+}
+
 // Service реализует InteractionService.
 type Service struct {
 	interactionv1.UnimplementedInteractionServiceServer
 	repo     *Repository
+	users    userv1.UserServiceClient
 	producer *kafka.Producer
+	opts     Options
 	logger   *zap.Logger
 }
 
-func NewService(repo *Repository, producer *kafka.Producer, logger *zap.Logger) *Service {
+// NewService создаёт InteractionService; через users проверяются баны.
+func NewService(repo *Repository, users userv1.UserServiceClient, producer *kafka.Producer, opts Options, logger *zap.Logger) *Service {
 	return &Service{
 		repo:     repo,
+		users:    users,
 		producer: producer,
+		opts:     opts,
 		logger:   logger,
 	}
 }
 
 func (s *Service) LikePost(ctx context.Context, req *interactionv1.LikePostRequest) (*interactionv1.LikePostResponse, error) {
+	previous, current, err := s.setVote(ctx, req.GetUserId(), req.GetPostId(), 1)
+	if err != nil {
+		return nil, err
+	}
+	return &interactionv1.LikePostResponse{PreviousVote: previous, CurrentVote: current}, nil
+}
+
+func (s *Service) DislikePost(ctx context.Context, req *interactionv1.DislikePostRequest) (*interactionv1.DislikePostResponse, error) {
+	previous, current, err := s.setVote(ctx, req.GetUserId(), req.GetPostId(), -1)
+	if err != nil {
+		return nil, err
+	}
+	return &interactionv1.DislikePostResponse{PreviousVote: previous, CurrentVote: current}, nil
+}
+
+// setVote ставит лайк (1) или дизлайк (-1). Новый голос публикует POST_LIKED/POST_DISLIKED,
+// смена голоса — VOTE_CHANGED, повтор того же голоса ничего не публикует. Голос под теневым
+// баном сохраняется, но не попадает в счётчики и событий не публикует.
+func (s *Service) setVote(ctx context.Context, userID, postID int64, voteType int16) (interactionv1.VoteState, interactionv1.VoteState, error) {
+	if userID == 0 || postID == 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "user_id and post_id are required")
+	}
+	shadow, err := authz.CheckBan(ctx, s.users, userID, userv1.BanScope_BAN_SCOPE_ALL)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	authorID, err := s.repo.GetPostAuthor(ctx, postID)
+	if err != nil {
+		if err == ErrPostNotFound {
+			return 0, 0, status.Error(codes.NotFound, "post not found")
+		}
+		s.logger.Error("failed to get post author", zap.Error(err))
+		return 0, 0, status.Error(codes.Internal, "failed to vote")
+	}
+
+	previous, err := s.repo.SetVote(ctx, userID, postID, voteType, !shadow)
+	if err != nil {
+		s.logger.Error("failed to set vote", zap.Error(err))
+		return 0, 0, status.Error(codes.Internal, "failed to vote")
+	}
+
+	switch {
+	case shadow, previous == voteType:
+	case previous != 0:
+		s.publishVoteEvent(ctx, eventsv1.EventType_EVENT_TYPE_VOTE_CHANGED, postID, userID, authorID, previous, voteType)
+	case voteType > 0:
+		s.publishEvent(ctx, eventsv1.EventType_EVENT_TYPE_POST_LIKED, postID, userID, authorID, 0)
+	default:
+		s.publishEvent(ctx, eventsv1.EventType_EVENT_TYPE_POST_DISLIKED, postID, userID, authorID, 0)
+	}
+
+	return toVoteState(previous), toVoteState(voteType), nil
+}
+
+func (s *Service) RemoveVote(ctx context.Context, req *interactionv1.RemoveVoteRequest) (*interactionv1.RemoveVoteResponse, error) {
 	if req.GetUserId() == 0 || req.GetPostId() == 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id and post_id are required")
 	}
@@ -48,23 +136,45 @@ func (s *Service) LikePost(ctx context.Context, req *interactionv1.LikePostReque
 			return nil, status.Error(codes.NotFound, "post not found")
 		}
 		s.logger.Error("failed to get post author", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to process like")
+		return nil, status.Error(codes.Internal, "failed to remove vote")
 	}
 
-	if err := s.repo.UpsertVote(ctx, req.GetUserId(), req.GetPostId(), 1); err != nil {
-		s.logger.Error("failed to upsert like", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to like post")
+	previous, counted, err := s.repo.RemoveVote(ctx, req.GetUserId(), req.GetPostId())
+	if err != nil {
+		s.logger.Error("failed to remove vote", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to remove vote")
 	}
 
-	s.publishEvent(ctx, eventsv1.EventType_EVENT_TYPE_POST_LIKED, req.GetPostId(), req.GetUserId(), authorID, 0)
+	if previous != 0 && counted {
+		s.publishVoteEvent(ctx, eventsv1.EventType_EVENT_TYPE_VOTE_REMOVED, req.GetPostId(), req.GetUserId(), authorID, previous, 0)
+	}
 
-	return &interactionv1.LikePostResponse{}, nil
+	return &interactionv1.RemoveVoteResponse{PreviousVote: toVoteState(previous), CurrentVote: interactionv1.VoteState_VOTE_STATE_NONE}, nil
 }
 
-func (s *Service) DislikePost(ctx context.Context, req *interactionv1.DislikePostRequest) (*interactionv1.DislikePostResponse, error) {
+func toVoteState(voteType int16) interactionv1.VoteState {
+	switch {
+	case voteType > 0:
+		return interactionv1.VoteState_VOTE_STATE_LIKE
+	case voteType < 0:
+		return interactionv1.VoteState_VOTE_STATE_DISLIKE
+	default:
+		return interactionv1.VoteState_VOTE_STATE_NONE
+	}
+}
+
+func (s *Service) ReactToPost(ctx context.Context, req *interactionv1.ReactToPostRequest) (*interactionv1.ReactToPostResponse, error) {
 	if req.GetUserId() == 0 || req.GetPostId() == 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id and post_id are required")
 	}
+	reaction := strings.TrimSpace(req.GetReaction())
+	if reaction != "" && !s.reactionAllowed(reaction) {
+		return nil, status.Errorf(codes.InvalidArgument, "reaction must be one of %s", strings.Join(s.opts.AllowedReactions, " "))
+	}
+	shadow, err := authz.CheckBan(ctx, s.users, req.GetUserId(), userv1.BanScope_BAN_SCOPE_ALL)
+	if err != nil {
+		return nil, err
+	}
 
 	authorID, err := s.repo.GetPostAuthor(ctx, req.GetPostId())
 	if err != nil {
@@ -72,17 +182,36 @@ func (s *Service) DislikePost(ctx context.Context, req *interactionv1.DislikePos
 			return nil, status.Error(codes.NotFound, "post not found")
 		}
 		s.logger.Error("failed to get post author", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to process dislike")
+		return nil, status.Error(codes.Internal, "failed to react")
 	}
 
-	if err := s.repo.UpsertVote(ctx, req.GetUserId(), req.GetPostId(), -1); err != nil {
-		s.logger.Error("failed to upsert dislike", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to dislike post")
+	previous, err := s.repo.SetReaction(ctx, req.GetUserId(), req.GetPostId(), reaction)
+	if err != nil {
+		s.logger.Error("failed to set reaction", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to react")
 	}
 
-	s.publishEvent(ctx, eventsv1.EventType_EVENT_TYPE_POST_DISLIKED, req.GetPostId(), req.GetUserId(), authorID, 0)
+	// снятие и повтор реакции, как и реакции под теневым баном, событий не порождают
+	if reaction != "" && reaction != previous && !shadow {
+		s.publish(ctx, &eventsv1.PostEvent{
+			EventType:    eventsv1.EventType_EVENT_TYPE_POST_REACTED,
+			PostId:       req.GetPostId(),
+			ActorUserId:  req.GetUserId(),
+			PostAuthorId: authorID,
+			Reaction:     reaction,
+		})
+	}
 
-	return &interactionv1.DislikePostResponse{}, nil
+	return &interactionv1.ReactToPostResponse{PreviousReaction: previous, Reaction: reaction}, nil
+}
+
+func (s *Service) reactionAllowed(reaction string) bool {
+	for _, allowed := range s.opts.AllowedReactions {
+		if reaction == allowed {
+			return true
+		}
+	}
+	return false
 }
 
 func (s *Service) AddComment(ctx context.Context, req *interactionv1.AddCommentRequest) (*interactionv1.AddCommentResponse, error) {
@@ -92,6 +221,14 @@ func (s *Service) AddComment(ctx context.Context, req *interactionv1.AddCommentR
 	if strings.TrimSpace(req.GetText()) == "" {
 		return nil, status.Error(codes.InvalidArgument, "text is required")
 	}
+	shadow, err := authz.CheckBan(ctx, s.users, req.GetUserId(), userv1.BanScope_BAN_SCOPE_COMMENTING)
+	if err != nil {
+		return nil, err
+	}
+	filtered := s.opts.ContentFilter.Check(req.GetText())
+	if hit, rejected := filtered.Rejected(); rejected {
+		return nil, status.Errorf(codes.InvalidArgument, "comment rejected: %s", hit.Detail)
+	}
 
 	authorID, err := s.repo.GetPostAuthor(ctx, req.GetPostId())
 	if err != nil {
@@ -102,17 +239,203 @@ func (s *Service) AddComment(ctx context.Context, req *interactionv1.AddCommentR
 		return nil, status.Error(codes.Internal, "failed to add comment")
 	}
 
-	commentID, err := s.repo.AddComment(ctx, req.GetUserId(), req.GetPostId(), req.GetText())
+	comment := NewComment{UserID: req.GetUserId(), PostID: req.GetPostId(), Text: req.GetText(), Shadow: shadow}
+	for _, hit := range filtered.Flags() {
+		comment.Flags = append(comment.Flags, Flag{Reason: "filter:" + hit.Rule, Details: hit.Detail})
+	}
+	var parent Comment
+	if req.GetParentCommentId() != 0 {
+		parent, err = s.repo.GetComment(ctx, req.GetParentCommentId())
+		if err != nil {
+			if err == ErrCommentNotFound {
+				return nil, status.Error(codes.NotFound, "parent comment not found")
+			}
+			s.logger.Error("failed to get parent comment", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to add comment")
+		}
+		if parent.PostID != req.GetPostId() {
+			return nil, status.Error(codes.InvalidArgument, "parent comment belongs to another post")
+		}
+		if parent.Depth >= maxCommentDepth {
+			return nil, status.Errorf(codes.FailedPrecondition, "reply depth limit %d reached", maxCommentDepth)
+		}
+		comment.ParentID, comment.Depth = parent.ID, parent.Depth+1
+	}
+
+	commentID, err := s.repo.AddComment(ctx, comment)
 	if err != nil {
 		s.logger.Error("failed to add comment", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to add comment")
 	}
+	// комментарий под теневым баном никто, кроме автора, не увидит — и уведомлять о нём некого
+	if shadow {
+		return &interactionv1.AddCommentResponse{CommentId: commentID}, nil
+	}
 
-	s.publishEvent(ctx, eventsv1.EventType_EVENT_TYPE_COMMENT_ADDED, req.GetPostId(), req.GetUserId(), authorID, commentID)
+	event := &eventsv1.PostEvent{
+		EventType:       eventsv1.EventType_EVENT_TYPE_COMMENT_ADDED,
+		PostId:          req.GetPostId(),
+		ActorUserId:     req.GetUserId(),
+		PostAuthorId:    authorID,
+		CommentId:       commentID,
+		ParentCommentId: parent.ID,
+		RecipientUserId: parent.UserID,
+	}
+	s.publish(ctx, event)
+	// ответ на собственный комментарий уведомления не порождает
+	if parent.ID != 0 && parent.UserID != req.GetUserId() {
+		replied := proto.Clone(event).(*eventsv1.PostEvent)
+		replied.EventType = eventsv1.EventType_EVENT_TYPE_COMMENT_REPLIED
+		s.publish(ctx, replied)
+	}
 
 	return &interactionv1.AddCommentResponse{CommentId: commentID}, nil
 }
 
+// commentLikeMilestones — рубежи лайков по возрастанию, о которых уведомляется автор комментария.
+var commentLikeMilestones = []int64{10, 50, 100, 500, 1000}
+
+func (s *Service) LikeComment(ctx context.Context, req *interactionv1.LikeCommentRequest) (*interactionv1.LikeCommentResponse, error) {
+	previous, current, err := s.setCommentVote(ctx, req.GetUserId(), req.GetCommentId(), 1)
+	if err != nil {
+		return nil, err
+	}
+	return &interactionv1.LikeCommentResponse{PreviousVote: previous, CurrentVote: current}, nil
+}
+
+func (s *Service) DislikeComment(ctx context.Context, req *interactionv1.DislikeCommentRequest) (*interactionv1.DislikeCommentResponse, error) {
+	previous, current, err := s.setCommentVote(ctx, req.GetUserId(), req.GetCommentId(), -1)
+	if err != nil {
+		return nil, err
+	}
+	return &interactionv1.DislikeCommentResponse{PreviousVote: previous, CurrentVote: current}, nil
+}
+
+// setCommentVote ставит голос за комментарий и, если новый лайк довёл счётчик до рубежа,
+// публикует COMMENT_LIKE_MILESTONE для автора комментария. Лайк под теневым баном рубежей не проверяет.
+func (s *Service) setCommentVote(ctx context.Context, userID, commentID int64, voteType int16) (interactionv1.VoteState, interactionv1.VoteState, error) {
+	if userID == 0 || commentID == 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "user_id and comment_id are required")
+	}
+	shadow, err := authz.CheckBan(ctx, s.users, userID, userv1.BanScope_BAN_SCOPE_ALL)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	comment, err := s.repo.GetComment(ctx, commentID)
+	if err != nil {
+		if err == ErrCommentNotFound {
+			return 0, 0, status.Error(codes.NotFound, "comment not found")
+		}
+		s.logger.Error("failed to get comment", zap.Error(err))
+		return 0, 0, status.Error(codes.Internal, "failed to vote")
+	}
+
+	previous, err := s.repo.SetCommentVote(ctx, userID, commentID, voteType)
+	if err != nil {
+		s.logger.Error("failed to set comment vote", zap.Error(err))
+		return 0, 0, status.Error(codes.Internal, "failed to vote")
+	}
+
+	if voteType > 0 && previous != voteType && !shadow {
+		s.checkLikeMilestone(ctx, comment)
+	}
+
+	return toVoteState(previous), toVoteState(voteType), nil
+}
+
+func (s *Service) checkLikeMilestone(ctx context.Context, comment Comment) {
+	likes, err := s.repo.CommentLikes(ctx, comment.ID)
+	if err != nil {
+		s.logger.Error("failed to count comment likes", zap.Error(err))
+		return
+	}
+
+	// берётся старший пройденный рубеж, а не точное совпадение: параллельные лайки могут
+	// перескочить рубеж, и тогда уведомление о нём потерялось бы; повторы отсекает MarkCommentMilestone
+	for i := len(commentLikeMilestones) - 1; i >= 0; i-- {
+		milestone := commentLikeMilestones[i]
+		if likes < milestone {
+			continue
+		}
+		reached, err := s.repo.MarkCommentMilestone(ctx, comment.ID, milestone)
+		if err != nil {
+			s.logger.Error("failed to mark comment milestone", zap.Error(err))
+			return
+		}
+		if reached {
+			s.publish(ctx, &eventsv1.PostEvent{
+				EventType:       eventsv1.EventType_EVENT_TYPE_COMMENT_LIKE_MILESTONE,
+				PostId:          comment.PostID,
+				PostAuthorId:    comment.PostAuthorID,
+				CommentId:       comment.ID,
+				RecipientUserId: comment.UserID,
+				Milestone:       milestone,
+			})
+		}
+		return
+	}
+}
+
+func (s *Service) EditComment(ctx context.Context, req *interactionv1.EditCommentRequest) (*interactionv1.EditCommentResponse, error) {
+	if req.GetUserId() == 0 || req.GetCommentId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and comment_id are required")
+	}
+	if strings.TrimSpace(req.GetText()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "text is required")
+	}
+	if _, err := authz.CheckBan(ctx, s.users, req.GetUserId(), userv1.BanScope_BAN_SCOPE_COMMENTING); err != nil {
+		return nil, err
+	}
+	// правка не должна обходить фильтр: отметки сохраняются так же, как при создании
+	filtered := s.opts.ContentFilter.Check(req.GetText())
+	if hit, rejected := filtered.Rejected(); rejected {
+		return nil, status.Errorf(codes.InvalidArgument, "comment rejected: %s", hit.Detail)
+	}
+	var flags []Flag
+	for _, hit := range filtered.Flags() {
+		flags = append(flags, Flag{Reason: "filter:" + hit.Rule, Details: hit.Detail})
+	}
+
+	comment, editedAt, err := s.repo.EditComment(ctx, req.GetUserId(), req.GetCommentId(), req.GetText(), flags)
+	if err != nil {
+		switch err {
+		case ErrCommentNotFound:
+			return nil, status.Error(codes.NotFound, "comment not found")
+		case ErrNotCommentAuthor:
+			return nil, status.Error(codes.PermissionDenied, "only the author can edit the comment")
+		}
+		s.logger.Error("failed to edit comment", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to edit comment")
+	}
+
+	s.publishEvent(ctx, eventsv1.EventType_EVENT_TYPE_COMMENT_EDITED, comment.PostID, req.GetUserId(), comment.PostAuthorID, comment.ID)
+
+	return &interactionv1.EditCommentResponse{EditedAt: editedAt.UTC().Format(time.RFC3339)}, nil
+}
+
+func (s *Service) DeleteComment(ctx context.Context, req *interactionv1.DeleteCommentRequest) (*interactionv1.DeleteCommentResponse, error) {
+	if req.GetUserId() == 0 || req.GetCommentId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and comment_id are required")
+	}
+
+	comment, err := s.repo.DeleteComment(ctx, req.GetUserId(), req.GetCommentId())
+	if err != nil {
+		switch err {
+		case ErrCommentNotFound:
+			return nil, status.Error(codes.NotFound, "comment not found")
+		case ErrNotCommentAuthor:
+			return nil, status.Error(codes.PermissionDenied, "only the comment or post author can delete the comment")
+		}
+		s.logger.Error("failed to delete comment", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to delete comment")
+	}
+
+	s.publishEvent(ctx, eventsv1.EventType_EVENT_TYPE_COMMENT_DELETED, comment.PostID, req.GetUserId(), comment.PostAuthorID, comment.ID)
+
+	return &interactionv1.DeleteCommentResponse{}, nil
+}
+
 func (s *Service) GetPostStats(ctx context.Context, req *interactionv1.GetPostStatsRequest) (*interactionv1.GetPostStatsResponse, error) {
 	if req.GetPostId() == 0 {
 		return nil, status.Error(codes.InvalidArgument, "post_id is required")
@@ -126,18 +449,13 @@ func (s *Service) GetPostStats(ctx context.Context, req *interactionv1.GetPostSt
 		return nil, status.Error(codes.Internal, "failed to get stats")
 	}
 
-	likes, dislikes, comments, views, err := s.repo.GetStats(ctx, req.GetPostId())
+	st, err := s.repo.GetStats(ctx, req.GetPostId(), req.GetIncludeReposts())
 	if err != nil {
 		s.logger.Error("failed to get stats", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to get stats")
 	}
 
-	return &interactionv1.GetPostStatsResponse{
-		Likes:    likes,
-		Dislikes: dislikes,
-		Comments: comments,
-		Views:    views,
-	}, nil
+	return toStatsResponse(st), nil
 }
 
 func (s *Service) ListPostComments(ctx context.Context, req *interactionv1.ListPostCommentsRequest) (*interactionv1.ListPostCommentsResponse, error) {
@@ -148,7 +466,8 @@ func (s *Service) ListPostComments(ctx context.Context, req *interactionv1.ListP
 		req.PageSize = 10
 	}
 
-	if _, err := s.repo.GetPostAuthor(ctx, req.GetPostId()); err != nil {
+	authorID, err := s.repo.GetPostAuthor(ctx, req.GetPostId())
+	if err != nil {
 		if err == ErrPostNotFound {
 			return nil, status.Error(codes.NotFound, "post not found")
 		}
@@ -156,7 +475,16 @@ func (s *Service) ListPostComments(ctx context.Context, req *interactionv1.ListP
 		return nil, status.Error(codes.Internal, "failed to list comments")
 	}
 
-	items, hasMore, err := s.repo.ListComments(ctx, req.GetPostId(), req.GetPage(), req.GetPageSize())
+	q, err := commentQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetLayout() == interactionv1.CommentLayout_COMMENT_LAYOUT_TREE {
+		return s.listCommentThreads(ctx, req, authorID, q)
+	}
+
+	items, hasMore, err := s.repo.ListComments(ctx, req.GetPostId(), q)
 	if err != nil {
 		s.logger.Error("failed to list comments", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to list comments")
@@ -169,17 +497,113 @@ func (s *Service) ListPostComments(ctx context.Context, req *interactionv1.ListP
 		HasMore:  hasMore,
 	}
 
-	for _, item := range items {
-		resp.Comments = append(resp.Comments, &interactionv1.CommentItem{
-			CommentId: item.ID,
-			Text:      item.Text,
-			CreatedAt: item.CreatedAt,
-		})
+	for i := range items {
+		resp.Comments = append(resp.Comments, s.toCommentItem(&items[i], req.GetPostId(), authorID))
+	}
+	if hasMore && len(items) > 0 {
+		last := items[len(items)-1]
+		resp.NextPageToken = nextCommentsToken(q, len(items), last.CreatedAt, last.ID)
+	}
+
+	return resp, nil
+}
+
+// listCommentThreads отдаёт страницу корневых комментариев с вложенными ответами.
+// Пагинация только токеном: page в этом режиме игнорируется.
+func (s *Service) listCommentThreads(ctx context.Context, req *interactionv1.ListPostCommentsRequest, authorID int64, q CommentQuery) (*interactionv1.ListPostCommentsResponse, error) {
+	if req.GetPageToken() == "" {
+		q.Offset = 0
+	}
+
+	roots, hasMore, err := s.repo.ListCommentThreads(ctx, req.GetPostId(), q)
+	if err != nil {
+		s.logger.Error("failed to list comment threads", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list comments")
+	}
+
+	resp := &interactionv1.ListPostCommentsResponse{
+		Comments: make([]*interactionv1.CommentItem, 0, len(roots)),
+		PageSize: req.GetPageSize(),
+		HasMore:  hasMore,
+	}
+	for _, root := range roots {
+		resp.Comments = append(resp.Comments, s.toCommentItem(root, req.GetPostId(), authorID))
+	}
+	if hasMore && len(roots) > 0 {
+		last := roots[len(roots)-1]
+		resp.NextPageToken = nextCommentsToken(q, len(roots), last.CreatedAt, last.ID)
 	}
 
 	return resp, nil
 }
 
+// commentQuery разбирает сортировку и пагинацию запроса. Сортировки по времени листаются
+// ключом (created_at, id), по оценкам — смещением; устаревший page работает для обеих.
+func commentQuery(req *interactionv1.ListPostCommentsRequest) (CommentQuery, error) {
+	q := CommentQuery{Limit: req.GetPageSize(), Viewer: req.GetUserId()}
+	switch req.GetSort() {
+	case interactionv1.CommentSort_COMMENT_SORT_OLD:
+		q.Sort = CommentSortOld
+	case interactionv1.CommentSort_COMMENT_SORT_TOP:
+		q.Sort = CommentSortTop
+	case interactionv1.CommentSort_COMMENT_SORT_CONTROVERSIAL:
+		q.Sort = CommentSortControversial
+	}
+	if req.GetPage() > 1 {
+		q.Offset = (req.GetPage() - 1) * req.GetPageSize()
+	}
+
+	if req.GetPageToken() == "" {
+		return q, nil
+	}
+	if q.Sort.Keyset() {
+		after, err := cursor.Decode(req.GetPageToken())
+		if err != nil {
+			return q, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		q.After = after
+		return q, nil
+	}
+	offset, err := cursor.DecodeOffset(req.GetPageToken())
+	if err != nil {
+		return q, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	q.Offset = offset
+	return q, nil
+}
+
+func nextCommentsToken(q CommentQuery, n int, lastCreatedAt time.Time, lastID int64) string {
+	if q.Sort.Keyset() {
+		return cursor.Encode(cursor.Cursor{CreatedAt: lastCreatedAt, ID: lastID})
+	}
+	return cursor.EncodeOffset(q.Offset + int32(n))
+}
+
+func (s *Service) toCommentItem(item *CommentItem, postID, authorID int64) *interactionv1.CommentItem {
+	out := &interactionv1.CommentItem{
+		CommentId:       item.ID,
+		Text:            item.Text,
+		CreatedAt:       item.CreatedAt.UTC().Format(time.RFC3339),
+		ParentCommentId: item.ParentID,
+		Depth:           int32(item.Depth),
+		Deleted:         item.Deleted,
+		Likes:           item.Likes,
+		Dislikes:        item.Dislikes,
+		Score:           item.Likes - item.Dislikes,
+	}
+	if item.EditedAt != nil {
+		out.EditedAt = item.EditedAt.UTC().Format(time.RFC3339)
+	}
+	// у удалённого комментария не раскрываем и псевдоним
+	if !item.Deleted {
+		out.AuthorPseudonym = pseudonym([]byte(s.opts.PseudonymSecret), postID, item.PseudonymIndex, item.UserID == authorID)
+	}
+	for _, reply := range item.Replies {
+		out.Replies = append(out.Replies, s.toCommentItem(reply, postID, authorID))
+	}
+	return out
+}
+
 func (s *Service) MarkPostViewed(ctx context.Context, req *interactionv1.MarkPostViewedRequest) (*interactionv1.MarkPostViewedResponse, error) {
 	if req.GetUserId() == 0 || req.GetPostId() == 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id and post_id are required")
@@ -207,28 +631,338 @@ func (s *Service) MarkPostViewed(ctx context.Context, req *interactionv1.MarkPos
 	return &interactionv1.MarkPostViewedResponse{}, nil
 }
 
-func (s *Service) publishEvent(ctx context.Context, eventType eventsv1.EventType, postID, actorID, postAuthorID, commentID int64) {
-	if s.producer == nil {
-		return
+// RecordEngagement сохраняет время показа поста и признак пропуска и публикует POST_ENGAGED.
+func (s *Service) RecordEngagement(ctx context.Context, req *interactionv1.RecordEngagementRequest) (*interactionv1.RecordEngagementResponse, error) {
+	if req.GetUserId() == 0 || req.GetPostId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and post_id are required")
+	}
+	if req.GetDwellMs() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "dwell_ms must not be negative")
 	}
 
-	event := &eventsv1.PostEvent{
-		EventId:      fmt.Sprintf("evt-%d-%d", postID, time.Now().UnixNano()),
+	authorID, err := s.repo.GetPostAuthor(ctx, req.GetPostId())
+	if err != nil {
+		if err == ErrPostNotFound {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+		s.logger.Error("failed to validate post", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to record engagement")
+	}
+
+	e := Engagement{
+		UserID:         req.GetUserId(),
+		PostID:         req.GetPostId(),
+		DwellMs:        req.GetDwellMs(),
+		CommentsOpened: req.GetCommentsOpened(),
+	}
+	if maxMs := s.opts.MaxDwell.Milliseconds(); maxMs > 0 && e.DwellMs > maxMs {
+		e.DwellMs = maxMs
+	}
+	e.Skipped = !e.CommentsOpened && e.DwellMs < s.opts.SkipThreshold.Milliseconds()
+
+	if err := s.repo.RecordEngagement(ctx, e); err != nil {
+		s.logger.Error("failed to record engagement", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to record engagement")
+	}
+
+	s.publish(ctx, &eventsv1.PostEvent{
+		EventType:      eventsv1.EventType_EVENT_TYPE_POST_ENGAGED,
+		PostId:         e.PostID,
+		ActorUserId:    e.UserID,
+		PostAuthorId:   authorID,
+		DwellMs:        e.DwellMs,
+		Skipped:        e.Skipped,
+		CommentsOpened: e.CommentsOpened,
+	})
+
+	return &interactionv1.RecordEngagementResponse{Skipped: e.Skipped, DwellMs: e.DwellMs}, nil
+}
+
+func (s *Service) BatchGetPostStats(ctx context.Context, req *interactionv1.BatchGetPostStatsRequest) (*interactionv1.BatchGetPostStatsResponse, error) {
+	postIDs := req.GetPostIds()
+	if len(postIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "post_ids is required")
+	}
+	if len(postIDs) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many post_ids: got %d, max %d", len(postIDs), maxBatchSize)
+	}
+
+	for _, id := range postIDs {
+		if id <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid post_id %d", id)
+		}
+	}
+	unique := ids.Unique(postIDs)
+
+	stats, err := s.repo.BatchGetStats(ctx, unique)
+	if err != nil {
+		s.logger.Error("failed to batch get stats", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get stats")
+	}
+
+	resp := &interactionv1.BatchGetPostStatsResponse{Stats: make(map[int64]*interactionv1.GetPostStatsResponse, len(stats))}
+	for _, id := range unique {
+		st, ok := stats[id]
+		if !ok {
+			resp.MissingPostIds = append(resp.MissingPostIds, id)
+			continue
+		}
+		resp.Stats[id] = toStatsResponse(st)
+	}
+	return resp, nil
+}
+
+func toStatsResponse(st PostStats) *interactionv1.GetPostStatsResponse {
+	return &interactionv1.GetPostStatsResponse{
+		Likes:     st.Likes,
+		Dislikes:  st.Dislikes,
+		Comments:  st.Comments,
+		Views:     st.Views,
+		Reposts:   st.Reposts,
+		Reactions: st.Reactions,
+		Saves:     st.Saves,
+	}
+}
+
+func (s *Service) VotePoll(ctx context.Context, req *interactionv1.VotePollRequest) (*interactionv1.VotePollResponse, error) {
+	if req.GetUserId() == 0 || req.GetPostId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and post_id are required")
+	}
+	optionIDs := ids.Unique(req.GetOptionIds())
+	if len(optionIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "option_ids are required")
+	}
+	shadow, err := authz.CheckBan(ctx, s.users, req.GetUserId(), userv1.BanScope_BAN_SCOPE_ALL)
+	if err != nil {
+		return nil, err
+	}
+
+	authorID, err := s.repo.GetPostAuthor(ctx, req.GetPostId())
+	if err != nil {
+		if err == ErrPostNotFound {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+		s.logger.Error("failed to get post author", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to vote")
+	}
+
+	poll, err := s.repo.GetPoll(ctx, req.GetPostId(), req.GetUserId())
+	if err != nil {
+		if err == ErrPollNotFound {
+			return nil, status.Error(codes.FailedPrecondition, "post is not a poll")
+		}
+		s.logger.Error("failed to get poll", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to vote")
+	}
+	if !poll.MultipleChoice && len(optionIDs) > 1 {
+		return nil, status.Error(codes.InvalidArgument, "poll allows only one option")
+	}
+
+	added, err := s.repo.VotePoll(ctx, req.GetUserId(), req.GetPostId(), optionIDs, poll.MultipleChoice)
+	if err != nil {
+		switch err {
+		case ErrInvalidPollOption:
+			return nil, status.Error(codes.InvalidArgument, "option does not belong to poll")
+		case ErrAlreadyVoted:
+			return nil, status.Error(codes.FailedPrecondition, "already voted")
+		}
+		s.logger.Error("failed to vote poll", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to vote")
+	}
+
+	if added && !shadow {
+		s.publishEvent(ctx, eventsv1.EventType_EVENT_TYPE_POLL_VOTED, req.GetPostId(), req.GetUserId(), authorID, 0)
+	}
+
+	results, err := s.pollResults(ctx, req.GetUserId(), req.GetPostId(), authorID)
+	if err != nil {
+		return nil, err
+	}
+	return &interactionv1.VotePollResponse{Results: results}, nil
+}
+
+func (s *Service) GetPollResults(ctx context.Context, req *interactionv1.GetPollResultsRequest) (*interactionv1.GetPollResultsResponse, error) {
+	if req.GetUserId() == 0 || req.GetPostId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and post_id are required")
+	}
+
+	authorID, err := s.repo.GetPostAuthor(ctx, req.GetPostId())
+	if err != nil {
+		if err == ErrPostNotFound {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+		s.logger.Error("failed to get post author", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get poll results")
+	}
+
+	return s.pollResults(ctx, req.GetUserId(), req.GetPostId(), authorID)
+}
+
+// pollResults собирает результаты опроса. Числа видны только автору и тем, кто уже проголосовал.
+func (s *Service) pollResults(ctx context.Context, userID, postID, authorID int64) (*interactionv1.GetPollResultsResponse, error) {
+	poll, err := s.repo.GetPoll(ctx, postID, userID)
+	if err != nil {
+		if err == ErrPollNotFound {
+			return nil, status.Error(codes.FailedPrecondition, "post is not a poll")
+		}
+		s.logger.Error("failed to get poll", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get poll results")
+	}
+
+	choices, err := s.repo.PollChoices(ctx, userID, postID)
+	if err != nil {
+		s.logger.Error("failed to get poll choices", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get poll results")
+	}
+	chosen := make(map[int64]bool, len(choices))
+	for _, id := range choices {
+		chosen[id] = true
+	}
+
+	visible := len(choices) > 0 || userID == authorID
+	resp := &interactionv1.GetPollResultsResponse{
+		Question:       poll.Question,
+		MultipleChoice: poll.MultipleChoice,
+		ResultsVisible: visible,
+		Options:        make([]*interactionv1.PollOptionResult, 0, len(poll.Options)),
+	}
+	if visible {
+		resp.TotalVoters = poll.TotalVoters
+	}
+
+	for _, opt := range poll.Options {
+		item := &interactionv1.PollOptionResult{
+			OptionId: opt.ID,
+			Text:     opt.Text,
+			Chosen:   chosen[opt.ID],
+		}
+		if visible {
+			item.Votes = opt.Votes
+			if poll.TotalVoters > 0 {
+				item.Percent = int32(opt.Votes * 100 / poll.TotalVoters)
+			}
+		}
+		resp.Options = append(resp.Options, item)
+	}
+	return resp, nil
+}
+
+// SavePost добавляет пост в закладки. Событий не публикует: кто сохранил пост, не раскрывается
+// даже автору, ему доступно только общее число в статистике.
+func (s *Service) SavePost(ctx context.Context, req *interactionv1.SavePostRequest) (*interactionv1.SavePostResponse, error) {
+	if req.GetUserId() == 0 || req.GetPostId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and post_id are required")
+	}
+
+	if _, err := s.repo.GetPostAuthor(ctx, req.GetPostId()); err != nil {
+		if err == ErrPostNotFound {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+		s.logger.Error("failed to validate post", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to save post")
+	}
+
+	alreadySaved, err := s.repo.SavePost(ctx, req.GetUserId(), req.GetPostId())
+	if err != nil {
+		s.logger.Error("failed to save post", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to save post")
+	}
+	return &interactionv1.SavePostResponse{AlreadySaved: alreadySaved}, nil
+}
+
+// UnsavePost убирает пост из закладок; работает и для уже удалённых постов.
+func (s *Service) UnsavePost(ctx context.Context, req *interactionv1.UnsavePostRequest) (*interactionv1.UnsavePostResponse, error) {
+	if req.GetUserId() == 0 || req.GetPostId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and post_id are required")
+	}
+
+	removed, err := s.repo.UnsavePost(ctx, req.GetUserId(), req.GetPostId())
+	if err != nil {
+		s.logger.Error("failed to unsave post", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to unsave post")
+	}
+	return &interactionv1.UnsavePostResponse{Removed: removed}, nil
+}
+
+func (s *Service) ListSavedPosts(ctx context.Context, req *interactionv1.ListSavedPostsRequest) (*interactionv1.ListSavedPostsResponse, error) {
+	if req.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	limit := req.GetPageSize()
+	if limit <= 0 {
+		limit = defaultSavedPageSize
+	}
+	if limit > maxSavedPageSize {
+		limit = maxSavedPageSize
+	}
+
+	after, err := cursor.Decode(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	items, hasMore, err := s.repo.ListSavedPosts(ctx, req.GetUserId(), after, limit)
+	if err != nil {
+		s.logger.Error("failed to list saved posts", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list saved posts")
+	}
+
+	resp := &interactionv1.ListSavedPostsResponse{
+		Posts:   make([]*interactionv1.SavedPost, 0, len(items)),
+		HasMore: hasMore,
+	}
+	for _, item := range items {
+		resp.Posts = append(resp.Posts, &interactionv1.SavedPost{
+			PostId:      item.PostID,
+			TextPreview: item.TextPreview,
+			MediaType:   item.MediaType,
+			SavedAt:     item.SavedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	if hasMore && len(items) > 0 {
+		last := items[len(items)-1]
+		resp.NextPageToken = cursor.Encode(cursor.Cursor{CreatedAt: last.SavedAt, ID: last.PostID})
+	}
+	return resp, nil
+}
+
+func (s *Service) publishEvent(ctx context.Context, eventType eventsv1.EventType, postID, actorID, postAuthorID, commentID int64) {
+	s.publish(ctx, &eventsv1.PostEvent{
 		EventType:    eventType,
 		PostId:       postID,
 		ActorUserId:  actorID,
 		PostAuthorId: postAuthorID,
 		CommentId:    commentID,
-		CreatedAt:    time.Now().UTC().Format(time.RFC3339Nano),
+	})
+}
+
+func (s *Service) publishVoteEvent(ctx context.Context, eventType eventsv1.EventType, postID, actorID, postAuthorID int64, previous, vote int16) {
+	s.publish(ctx, &eventsv1.PostEvent{
+		EventType:    eventType,
+		PostId:       postID,
+		ActorUserId:  actorID,
+		PostAuthorId: postAuthorID,
+		PreviousVote: int32(previous),
+		Vote:         int32(vote),
+	})
+}
+
+// publish дополняет событие идентификатором и временем и отправляет его в post-events.
+func (s *Service) publish(ctx context.Context, event *eventsv1.PostEvent) {
+	if s.producer == nil {
+		return
 	}
 
+	event.EventId = fmt.Sprintf("evt-%d-%d", event.GetPostId(), time.Now().UnixNano())
+	event.CreatedAt = time.Now().UTC().Format(time.RFC3339Nano)
+
 	payload, err := proto.Marshal(event)
 	if err != nil {
 		s.logger.Error("failed to marshal event", zap.Error(err))
 		return
 	}
 
-	key := []byte(strconv.FormatInt(postID, 10))
+	key := []byte(strconv.FormatInt(event.GetPostId(), 10))
 	if err := s.producer.Send(ctx, topicPostEvents, key, payload); err != nil {
 		s.logger.Error("failed to publish interaction event", zap.Error(err))
 	}
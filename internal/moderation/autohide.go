@@ -0,0 +1,114 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventsv1 "ghostnet/gen/go/proto/events/v1"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// autoHideSeverity — тяжесть системной жалобы: выше спама и травли, чтобы скрытые посты
+// проверялись быстро, но ниже насилия и незаконного контента.
+const autoHideSeverity int16 = 4
+
+// AutoHideOptions — пороги автоскрытия. Нулевой порог отключает соответствующую проверку.
+type AutoHideOptions struct {
+	// DislikeWindow, MinVotes и DislikeRatio: учитываются только голоса за окно, и доля дизлайков
+	// среди них что-то значит, когда голосов не меньше MinVotes.
+	DislikeWindow time.Duration
+	MinVotes      int64
+	DislikeRatio  float64
+	// ReportWindow и ReportThreshold: сколько разных пользователей должны пожаловаться за окно.
+	ReportWindow    time.Duration
+	ReportThreshold int64
+}
+
+// AutoHider читает post-events и скрывает посты, которые массово дизлайкают или на которые
+// быстро набираются жалобы, до того как их увидит модератор.
+type AutoHider struct {
+	svc    *Service
+	opts   AutoHideOptions
+	logger *zap.Logger
+}
+
+func NewAutoHider(svc *Service, opts AutoHideOptions, logger *zap.Logger) *AutoHider {
+	return &AutoHider{svc: svc, opts: opts, logger: logger}
+}
+
+func (a *AutoHider) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
+func (a *AutoHider) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
+
+func (a *AutoHider) Consume(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var event eventsv1.PostEvent
+	if err := proto.Unmarshal(msg.Value, &event); err != nil {
+		a.logger.Warn("failed to unmarshal event", zap.Error(err))
+		return nil
+	}
+
+	switch event.GetEventType() {
+	case eventsv1.EventType_EVENT_TYPE_POST_LIKED,
+		eventsv1.EventType_EVENT_TYPE_POST_DISLIKED,
+		eventsv1.EventType_EVENT_TYPE_VOTE_CHANGED,
+		eventsv1.EventType_EVENT_TYPE_VOTE_REMOVED,
+		eventsv1.EventType_EVENT_TYPE_POST_REPORTED:
+		return a.evaluate(ctx, event.GetPostId())
+	default:
+		return nil
+	}
+}
+
+// evaluate пересчитывает сигналы поста и скрывает его, если пройден один из порогов.
+func (a *AutoHider) evaluate(ctx context.Context, postID int64) error {
+	now := time.Now()
+	signals, err := a.svc.repo.PostSignals(ctx, postID, now.Add(-a.opts.DislikeWindow), now.Add(-a.opts.ReportWindow))
+	if err == ErrTargetNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if signals.Hidden || signals.Cleared {
+		return nil
+	}
+
+	details, ok := a.trigger(signals)
+	if !ok {
+		return nil
+	}
+
+	target, hidden, err := a.svc.repo.AutoHidePost(ctx, postID, autoHideSeverity, details)
+	if err != nil {
+		return err
+	}
+	if !hidden {
+		return nil
+	}
+
+	a.logger.Info("post auto-hidden", zap.Int64("post_id", postID), zap.String("details", details))
+	a.svc.publish(ctx, eventsv1.ModerationEventType_MODERATION_EVENT_TYPE_CONTENT_AUTO_HIDDEN, Resolution{Target: target}, 0, details)
+	a.svc.publishPostEvent(ctx, &eventsv1.PostEvent{
+		EventType:    eventsv1.EventType_EVENT_TYPE_POST_AUTO_HIDDEN,
+		PostId:       postID,
+		PostAuthorId: target.AuthorID,
+	})
+	return nil
+}
+
+// trigger возвращает описание сработавшего порога для модератора.
+func (a *AutoHider) trigger(s PostSignals) (string, bool) {
+	votes := s.RecentLikes + s.RecentDislikes
+	if a.opts.MinVotes > 0 && a.opts.DislikeRatio > 0 && votes >= a.opts.MinVotes {
+		if ratio := float64(s.RecentDislikes) / float64(votes); ratio >= a.opts.DislikeRatio {
+			return fmt.Sprintf("%d of %d votes within %s are dislikes", s.RecentDislikes, votes, a.opts.DislikeWindow), true
+		}
+	}
+	if a.opts.ReportThreshold > 0 && s.RecentReports >= a.opts.ReportThreshold {
+		return fmt.Sprintf("%d reports within %s", s.RecentReports, a.opts.ReportWindow), true
+	}
+	return "", false
+}
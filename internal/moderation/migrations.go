@@ -0,0 +1,145 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const createReportsTable = `
+CREATE TABLE IF NOT EXISTS reports (
+    id               BIGSERIAL PRIMARY KEY,
+    target_type      VARCHAR(16) NOT NULL,
+    target_id        BIGINT NOT NULL,
+    post_id          BIGINT NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    reporter_user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason           SMALLINT NOT NULL,
+    severity         SMALLINT NOT NULL,
+    details          TEXT NOT NULL DEFAULT '',
+    status           VARCHAR(16) NOT NULL DEFAULT 'open',
+    resolution       VARCHAR(16),
+    resolved_by      BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    resolution_note  TEXT NOT NULL DEFAULT '',
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at      TIMESTAMPTZ
+);`
+
+// пользователь жалуется на один и тот же контент только один раз
+const createReportsReporterIndex = `
+CREATE UNIQUE INDEX IF NOT EXISTS uq_reports_reporter ON reports (target_type, target_id, reporter_user_id);`
+
+const createReportsOpenIndex = `
+CREATE INDEX IF NOT EXISTS idx_reports_open_target ON reports (target_type, target_id) WHERE status = 'open';`
+
+// hidden_at отличает скрытый модератором контент от удалённого, чтобы его можно было вернуть
+const addPostHiddenAtColumn = `
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS hidden_at TIMESTAMPTZ;`
+
+const addCommentHiddenAtColumn = `
+ALTER TABLE post_comments ADD COLUMN IF NOT EXISTS hidden_at TIMESTAMPTZ;`
+
+const addReportAuthorColumn = `
+ALTER TABLE reports ADD COLUMN IF NOT EXISTS author_user_id BIGINT;`
+
+const backfillReportAuthors = `
+UPDATE reports r
+SET author_user_id = COALESCE(
+    (SELECT author_user_id FROM posts WHERE r.target_type = 'post' AND id = r.target_id),
+    (SELECT user_id FROM post_comments WHERE r.target_type = 'comment' AND id = r.target_id))
+WHERE r.author_user_id IS NULL;`
+
+const createReportsAuthorIndex = `
+CREATE INDEX IF NOT EXISTS idx_reports_author_resolution ON reports (author_user_id, resolution);`
+
+// moderation_audit_log — журнал решений модераторов. Внешних ключей нет намеренно:
+// записи не должны исчезать вместе с пользователями.
+const createAuditLogTable = `
+CREATE TABLE IF NOT EXISTS moderation_audit_log (
+    id                BIGSERIAL PRIMARY KEY,
+    moderator_user_id BIGINT NOT NULL,
+    action            VARCHAR(16) NOT NULL,
+    target_type       VARCHAR(16) NOT NULL,
+    target_id         BIGINT NOT NULL,
+    author_user_id    BIGINT NOT NULL,
+    report_ids        BIGINT[] NOT NULL DEFAULT '{}',
+    note              TEXT NOT NULL DEFAULT '',
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);`
+
+const createAuditLogGuardFunction = `
+CREATE OR REPLACE FUNCTION moderation_audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'moderation_audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;`
+
+const createAuditLogRowGuard = `
+CREATE OR REPLACE TRIGGER moderation_audit_log_no_change
+    BEFORE UPDATE OR DELETE ON moderation_audit_log
+    FOR EACH ROW EXECUTE FUNCTION moderation_audit_log_append_only();`
+
+const createAuditLogTruncateGuard = `
+CREATE OR REPLACE TRIGGER moderation_audit_log_no_truncate
+    BEFORE TRUNCATE ON moderation_audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION moderation_audit_log_append_only();`
+
+// системные жалобы автоскрытия не имеют автора
+const dropReporterNotNull = `
+ALTER TABLE reports ALTER COLUMN reporter_user_id DROP NOT NULL;`
+
+// auto_hidden_at отмечает автоматическое скрытие; auto_hide_cleared_at — что модератор
+// вернул пост, и скрывать его автоматически больше нельзя.
+const addPostAutoHideColumns = `
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS auto_hidden_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS auto_hide_cleared_at TIMESTAMPTZ;`
+
+const createReportsRecentIndex = `
+CREATE INDEX IF NOT EXISTS idx_reports_target_created ON reports (target_type, target_id, created_at);`
+
+// автоскрытие считает только недавние голоса поста
+const createVotesRecentIndex = `
+CREATE INDEX IF NOT EXISTS idx_post_votes_post_updated ON post_votes (post_id, updated_at);`
+
+// flag_source и flag_id связывают системную жалобу с автоматической отметкой, из которой она
+// создана, чтобы каждая отметка попадала в очередь один раз.
+const addReportFlagColumns = `
+ALTER TABLE reports
+    ADD COLUMN IF NOT EXISTS flag_source VARCHAR(16),
+    ADD COLUMN IF NOT EXISTS flag_id BIGINT;`
+
+const createReportsFlagIndex = `
+CREATE UNIQUE INDEX IF NOT EXISTS uq_reports_flag ON reports (flag_source, flag_id) WHERE flag_id IS NOT NULL;`
+
+// RunMigrations выполняет миграции модуля модерации. Таблицы posts и post_comments
+// должны уже существовать.
+func RunMigrations(ctx context.Context, pool *pgxpool.Pool) error {
+	stmts := []string{
+		createReportsTable,
+		createReportsReporterIndex,
+		createReportsOpenIndex,
+		addPostHiddenAtColumn,
+		addCommentHiddenAtColumn,
+		addReportAuthorColumn,
+		backfillReportAuthors,
+		createReportsAuthorIndex,
+		createAuditLogTable,
+		createAuditLogGuardFunction,
+		createAuditLogRowGuard,
+		createAuditLogTruncateGuard,
+		dropReporterNotNull,
+		addPostAutoHideColumns,
+		createReportsRecentIndex,
+		addReportFlagColumns,
+		createReportsFlagIndex,
+		createVotesRecentIndex,
+	}
+
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("apply migration: %w", err)
+		}
+	}
+	return nil
+}
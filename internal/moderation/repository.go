@@ -0,0 +1,435 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Типы объектов жалоб в колонке reports.target_type.
+const (
+	targetPost    = "post"
+	targetComment = "comment"
+)
+
+// Решения по жалобам в колонке reports.resolution.
+const (
+	resolutionDismiss = "dismiss"
+	resolutionHide    = "hide"
+	resolutionBan     = "ban"
+)
+
+// actionRestore пишется в журнал вместо dismiss, когда отклонение вернуло скрытый пост.
+const actionRestore = "restore"
+
+// reasonAutoHidden — причина системной жалобы, совпадает с REPORT_REASON_AUTO_HIDDEN.
+const reasonAutoHidden int16 = 9
+
+// reasonAutoFlagged совпадает с REPORT_REASON_AUTO_FLAGGED; такие жалобы проверяются
+// с тяжестью спама: контент остаётся видимым.
+const (
+	reasonAutoFlagged   int16 = 10
+	autoFlaggedSeverity int16 = 1
+)
+
+var (
+	// ErrTargetNotFound возвращается, когда пост или комментарий отсутствует или уже скрыт.
+	ErrTargetNotFound = errors.New("target not found")
+	// ErrAlreadyReported возвращается при повторной жалобе пользователя на тот же объект.
+	ErrAlreadyReported = errors.New("already reported")
+	// ErrReportNotFound возвращается, когда открытой жалобы с таким id нет.
+	ErrReportNotFound = errors.New("report not found")
+)
+
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{pool: pool}
+}
+
+// Target — объект жалобы: пост или комментарий и их автор.
+type Target struct {
+	Type     string
+	ID       int64
+	PostID   int64
+	AuthorID int64
+}
+
+// GetTarget возвращает видимый пост или комментарий.
+func (r *Repository) GetTarget(ctx context.Context, targetType string, targetID int64) (Target, error) {
+	t := Target{Type: targetType, ID: targetID}
+	var err error
+	switch targetType {
+	case targetPost:
+		t.PostID = targetID
+		err = r.pool.QueryRow(ctx, `
+			SELECT author_user_id FROM posts WHERE id = $1 AND is_deleted = FALSE
+		`, targetID).Scan(&t.AuthorID)
+	case targetComment:
+		err = r.pool.QueryRow(ctx, `
+			SELECT c.post_id, c.user_id
+			FROM post_comments c
+			JOIN posts p ON p.id = c.post_id
+			WHERE c.id = $1 AND c.is_deleted = FALSE AND p.is_deleted = FALSE
+		`, targetID).Scan(&t.PostID, &t.AuthorID)
+	default:
+		return t, fmt.Errorf("unknown target type %q", targetType)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return t, ErrTargetNotFound
+	}
+	if err != nil {
+		return t, fmt.Errorf("get target: %w", err)
+	}
+	return t, nil
+}
+
+// NewReport — жалоба пользователя.
+type NewReport struct {
+	Target     Target
+	ReporterID int64
+	Reason     int16
+	Severity   int16
+	Details    string
+}
+
+func (r *Repository) CreateReport(ctx context.Context, rep NewReport) (int64, error) {
+	var id int64
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO reports (target_type, target_id, post_id, author_user_id, reporter_user_id, reason, severity, details)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`, rep.Target.Type, rep.Target.ID, rep.Target.PostID, rep.Target.AuthorID, rep.ReporterID, rep.Reason, rep.Severity, rep.Details).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "uq_reports_reporter" {
+			return 0, ErrAlreadyReported
+		}
+		return 0, fmt.Errorf("insert report: %w", err)
+	}
+	return id, nil
+}
+
+// QueueItem — открытые жалобы на один объект.
+type QueueItem struct {
+	ReportID        int64
+	Target          Target
+	TextPreview     string
+	Severity        int16
+	ReportCount     int64
+	Reasons         []int16
+	FirstReportedAt time.Time
+	// AuthorStrikes — число объектов автора, уже скрытых по жалобам.
+	AuthorStrikes int64
+	// AutoHidden — объект скрыт автоматически и ждёт решения.
+	AutoHidden bool
+}
+
+// ListQueue группирует открытые жалобы по объекту и упорядочивает по максимальной
+// тяжести, затем по числу жалоб, затем по давности первой жалобы. Для каждого объекта
+// считается число прошлых страйков автора.
+func (r *Repository) ListQueue(ctx context.Context, offset, limit int32) ([]QueueItem, bool, error) {
+	rows, err := r.pool.Query(ctx, `
+		WITH q AS (
+			SELECT MIN(id) AS report_id,
+			       target_type,
+			       target_id,
+			       MAX(post_id) AS post_id,
+			       MAX(severity) AS severity,
+			       COUNT(*) AS report_count,
+			       ARRAY_AGG(DISTINCT reason) AS reasons,
+			       MIN(created_at) AS first_reported_at
+			FROM reports
+			WHERE status = 'open'
+			GROUP BY target_type, target_id
+		)
+		SELECT q.report_id, q.target_type, q.target_id, q.post_id,
+		       COALESCE(p.author_user_id, c.user_id, 0),
+		       LEFT(COALESCE(p.text, c.text, ''), 1000),
+		       q.severity, q.report_count, q.reasons, q.first_reported_at,
+		       (SELECT COUNT(DISTINCT (s.target_type, s.target_id))
+		        FROM reports s
+		        WHERE s.author_user_id = COALESCE(p.author_user_id, c.user_id)
+		          AND s.resolution IN ('hide', 'ban')),
+		       p.auto_hidden_at IS NOT NULL
+		FROM q
+		LEFT JOIN posts p ON q.target_type = 'post' AND p.id = q.target_id
+		LEFT JOIN post_comments c ON q.target_type = 'comment' AND c.id = q.target_id
+		ORDER BY q.severity DESC, q.report_count DESC, q.first_reported_at, q.report_id
+		LIMIT $1 OFFSET $2
+	`, limit+1, offset)
+	if err != nil {
+		return nil, false, fmt.Errorf("list queue: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]QueueItem, 0, limit)
+	for rows.Next() {
+		var it QueueItem
+		if err := rows.Scan(&it.ReportID, &it.Target.Type, &it.Target.ID, &it.Target.PostID, &it.Target.AuthorID,
+			&it.TextPreview, &it.Severity, &it.ReportCount, &it.Reasons, &it.FirstReportedAt, &it.AuthorStrikes, &it.AutoHidden); err != nil {
+			return nil, false, fmt.Errorf("scan queue item: %w", err)
+		}
+		items = append(items, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("list queue: %w", err)
+	}
+
+	hasMore := false
+	if int32(len(items)) > limit {
+		hasMore = true
+		items = items[:limit]
+	}
+	return items, hasMore, nil
+}
+
+// ImportFlags ставит в очередь системными жалобами автоматические отметки из post_flags
+// и comment_flags, которых там ещё нет. Отметки скрытого и удалённого контента пропускаются.
+func (r *Repository) ImportFlags(ctx context.Context) (int64, error) {
+	posts, err := r.pool.Exec(ctx, `
+		INSERT INTO reports (target_type, target_id, post_id, author_user_id, reason, severity, details, flag_source, flag_id, created_at)
+		SELECT 'post', p.id, p.id, p.author_user_id, $1, $2, LEFT(f.reason || ': ' || f.details, 500), 'post', f.id, f.created_at
+		FROM post_flags f
+		JOIN posts p ON p.id = f.post_id
+		WHERE p.is_deleted = FALSE
+		  AND NOT EXISTS (SELECT 1 FROM reports r WHERE r.flag_source = 'post' AND r.flag_id = f.id)
+		ON CONFLICT (flag_source, flag_id) WHERE flag_id IS NOT NULL DO NOTHING
+	`, reasonAutoFlagged, autoFlaggedSeverity)
+	if err != nil {
+		return 0, fmt.Errorf("import post flags: %w", err)
+	}
+
+	comments, err := r.pool.Exec(ctx, `
+		INSERT INTO reports (target_type, target_id, post_id, author_user_id, reason, severity, details, flag_source, flag_id, created_at)
+		SELECT 'comment', c.id, c.post_id, c.user_id, $1, $2, LEFT(f.reason || ': ' || f.details, 500), 'comment', f.id, f.created_at
+		FROM comment_flags f
+		JOIN post_comments c ON c.id = f.comment_id
+		JOIN posts p ON p.id = c.post_id
+		WHERE c.is_deleted = FALSE AND p.is_deleted = FALSE
+		  AND NOT EXISTS (SELECT 1 FROM reports r WHERE r.flag_source = 'comment' AND r.flag_id = f.id)
+		ON CONFLICT (flag_source, flag_id) WHERE flag_id IS NOT NULL DO NOTHING
+	`, reasonAutoFlagged, autoFlaggedSeverity)
+	if err != nil {
+		return 0, fmt.Errorf("import comment flags: %w", err)
+	}
+	return posts.RowsAffected() + comments.RowsAffected(), nil
+}
+
+// Resolution — результат решения по жалобам на один объект.
+type Resolution struct {
+	Target    Target
+	ReportIDs []int64
+	// Restored — отклонение жалоб вернуло автоматически скрытый пост.
+	Restored bool
+}
+
+// Resolve закрывает все открытые жалобы на объект жалобы reportID и применяет решение:
+// скрывает объект для hide и ban, а dismiss возвращает автоматически скрытый пост.
+// Для ban вызывается banAuthor, когда жалоба уже заблокирована и автор известен: ошибка
+// banAuthor откатывает решение, так что бан не остаётся без записи в moderation_audit_log.
+// Решение пишется в moderation_audit_log в той же транзакции.
+func (r *Repository) Resolve(ctx context.Context, reportID, moderatorID int64, resolution, note string, banAuthor func(Target) error) (Resolution, error) {
+	var res Resolution
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return res, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	t := &res.Target
+	err = tx.QueryRow(ctx, `
+		SELECT target_type, target_id, post_id FROM reports
+		WHERE id = $1 AND status = 'open'
+		FOR UPDATE
+	`, reportID).Scan(&t.Type, &t.ID, &t.PostID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, ErrReportNotFound
+	}
+	if err != nil {
+		return res, fmt.Errorf("get report: %w", err)
+	}
+
+	// автор берётся без фильтра is_deleted: объект мог быть скрыт раньше
+	if t.Type == targetPost {
+		err = tx.QueryRow(ctx, `SELECT author_user_id FROM posts WHERE id = $1`, t.ID).Scan(&t.AuthorID)
+	} else {
+		err = tx.QueryRow(ctx, `SELECT user_id FROM post_comments WHERE id = $1`, t.ID).Scan(&t.AuthorID)
+	}
+	if err != nil {
+		return res, fmt.Errorf("get target author: %w", err)
+	}
+	if resolution == resolutionBan && banAuthor != nil {
+		if err := banAuthor(*t); err != nil {
+			return res, fmt.Errorf("ban author: %w", err)
+		}
+	}
+
+	rows, err := tx.Query(ctx, `
+		UPDATE reports
+		SET status = 'resolved', resolution = $3, resolved_by = $4, resolution_note = $5, resolved_at = NOW()
+		WHERE target_type = $1 AND target_id = $2 AND status = 'open'
+		RETURNING id
+	`, t.Type, t.ID, resolution, moderatorID, note)
+	if err != nil {
+		return res, fmt.Errorf("resolve reports: %w", err)
+	}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return res, fmt.Errorf("scan report id: %w", err)
+		}
+		res.ReportIDs = append(res.ReportIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return res, fmt.Errorf("resolve reports: %w", err)
+	}
+
+	if resolution == resolutionHide || resolution == resolutionBan {
+		if err := hideTarget(ctx, tx, *t); err != nil {
+			return res, err
+		}
+	}
+	action := resolution
+	if resolution == resolutionDismiss && t.Type == targetPost {
+		tag, err := tx.Exec(ctx, `
+			UPDATE posts
+			SET is_deleted = FALSE, hidden_at = NULL, auto_hidden_at = NULL, auto_hide_cleared_at = NOW()
+			WHERE id = $1 AND auto_hidden_at IS NOT NULL
+		`, t.ID)
+		if err != nil {
+			return res, fmt.Errorf("restore post: %w", err)
+		}
+		if res.Restored = tag.RowsAffected() > 0; res.Restored {
+			action = actionRestore
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO moderation_audit_log (moderator_user_id, action, target_type, target_id, author_user_id, report_ids, note)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, moderatorID, action, t.Type, t.ID, t.AuthorID, res.ReportIDs, note); err != nil {
+		return res, fmt.Errorf("insert audit log: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return res, fmt.Errorf("commit: %w", err)
+	}
+	return res, nil
+}
+
+// hideTarget скрывает пост или комментарий так, чтобы его можно было вернуть по hidden_at.
+func hideTarget(ctx context.Context, tx pgx.Tx, t Target) error {
+	table := "posts"
+	if t.Type == targetComment {
+		table = "post_comments"
+	}
+	tag, err := tx.Exec(ctx, `
+		UPDATE `+table+` SET is_deleted = TRUE, hidden_at = NOW()
+		WHERE id = $1 AND is_deleted = FALSE
+	`, t.ID)
+	if err != nil {
+		return fmt.Errorf("hide %s: %w", t.Type, err)
+	}
+	// скрытый комментарий перестаёт учитываться в post_counters, как и удалённый автором;
+	// комментарии автора под теневым баном там и не учитывались
+	if t.Type == targetComment && tag.RowsAffected() > 0 {
+		if _, err := tx.Exec(ctx, `
+			UPDATE post_counters SET comments = comments - 1, updated_at = NOW()
+			WHERE post_id = $1 AND NOT EXISTS (
+			    SELECT 1 FROM user_bans b
+			    WHERE b.user_id = $2 AND b.shadow AND b.revoked_at IS NULL
+			      AND (b.expires_at IS NULL OR b.expires_at > NOW())
+			      AND b.scope IN ('commenting', 'all'))
+		`, t.PostID, t.AuthorID); err != nil {
+			return fmt.Errorf("update post counters: %w", err)
+		}
+	}
+	return nil
+}
+
+// PostSignals — сигналы для автоскрытия поста.
+type PostSignals struct {
+	AuthorID       int64
+	Hidden         bool
+	Cleared        bool  // модератор уже вернул пост после автоскрытия
+	RecentLikes    int64 // голоса, поставленные или изменённые начиная с votesSince
+	RecentDislikes int64
+	RecentReports  int64 // разные пользователи с открытой жалобой, поданной начиная с reportsSince
+}
+
+// PostSignals собирает недавние голоса и жалобы на пост. Голоса пользователей под теневым
+// баном не учитываются, как и в post_counters, а жалобы, по которым модератор уже принял
+// решение, — чтобы отклонённые жалобы не скрывали пост повторно.
+func (r *Repository) PostSignals(ctx context.Context, postID int64, votesSince, reportsSince time.Time) (PostSignals, error) {
+	var s PostSignals
+	err := r.pool.QueryRow(ctx, `
+		SELECT p.author_user_id, p.is_deleted, p.auto_hide_cleared_at IS NOT NULL,
+		       COUNT(v.user_id) FILTER (WHERE v.vote_type = 1),
+		       COUNT(v.user_id) FILTER (WHERE v.vote_type = -1),
+		       (SELECT COUNT(DISTINCT reporter_user_id) FROM reports
+		        WHERE target_type = 'post' AND target_id = p.id AND status = 'open' AND created_at >= $3)
+		FROM posts p
+		LEFT JOIN post_votes v ON v.post_id = p.id AND v.updated_at >= $2
+		    AND NOT EXISTS (
+		        SELECT 1 FROM user_bans b
+		        WHERE b.user_id = v.user_id AND b.shadow AND b.revoked_at IS NULL
+		          AND (b.expires_at IS NULL OR b.expires_at > NOW())
+		          AND b.scope = 'all')
+		WHERE p.id = $1
+		GROUP BY p.id
+	`, postID, votesSince, reportsSince).Scan(&s.AuthorID, &s.Hidden, &s.Cleared, &s.RecentLikes, &s.RecentDislikes, &s.RecentReports)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return s, ErrTargetNotFound
+	}
+	if err != nil {
+		return s, fmt.Errorf("get post signals: %w", err)
+	}
+	return s, nil
+}
+
+// AutoHidePost скрывает пост и ставит его в очередь модерации системной жалобой.
+// Возвращает false, если пост уже скрыт или модератор запретил его автоскрытие.
+func (r *Repository) AutoHidePost(ctx context.Context, postID int64, severity int16, details string) (Target, bool, error) {
+	t := Target{Type: targetPost, ID: postID, PostID: postID}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return t, false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+		UPDATE posts SET is_deleted = TRUE, hidden_at = NOW(), auto_hidden_at = NOW()
+		WHERE id = $1 AND is_deleted = FALSE AND auto_hide_cleared_at IS NULL
+		RETURNING author_user_id
+	`, postID).Scan(&t.AuthorID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return t, false, nil
+	}
+	if err != nil {
+		return t, false, fmt.Errorf("auto hide post: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO reports (target_type, target_id, post_id, author_user_id, reason, severity, details)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, t.Type, t.ID, t.PostID, t.AuthorID, reasonAutoHidden, severity, details); err != nil {
+		return t, false, fmt.Errorf("insert system report: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return t, false, fmt.Errorf("commit: %w", err)
+	}
+	return t, true, nil
+}
@@ -0,0 +1,349 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	eventsv1 "ghostnet/gen/go/proto/events/v1"
+	moderationv1 "ghostnet/gen/go/proto/moderation/v1"
+	userv1 "ghostnet/gen/go/proto/user/v1"
+	"ghostnet/internal/common/authz"
+	"ghostnet/internal/common/cursor"
+	"ghostnet/internal/common/kafka"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	topicModerationEvents = "moderation-events"
+	topicPostEvents       = "post-events"
+
+	maxDetailsLength = 500
+	maxNoteLength    = 1000
+	defaultQueueSize = 20
+	maxQueueSize     = 100
+)
+
+// reasonSeverity задаёт тяжесть причин жалоб: очередь сортируется по максимальной.
+var reasonSeverity = map[moderationv1.ReportReason]int16{
+	moderationv1.ReportReason_REPORT_REASON_SPAM:          1,
+	moderationv1.ReportReason_REPORT_REASON_OTHER:         1,
+	moderationv1.ReportReason_REPORT_REASON_HARASSMENT:    2,
+	moderationv1.ReportReason_REPORT_REASON_HATE:          3,
+	moderationv1.ReportReason_REPORT_REASON_SEXUAL:        3,
+	moderationv1.ReportReason_REPORT_REASON_PERSONAL_INFO: 3,
+	moderationv1.ReportReason_REPORT_REASON_VIOLENCE:      4,
+	moderationv1.ReportReason_REPORT_REASON_ILLEGAL:       5,
+}
+
+// Service реализует ModerationService.
+type Service struct {
+	moderationv1.UnimplementedModerationServiceServer
+	repo     *Repository
+	users    userv1.UserServiceClient
+	producer *kafka.Producer
+	logger   *zap.Logger
+}
+
+// NewService создаёт сервис модерации; права модераторов проверяются через users.
+func NewService(repo *Repository, users userv1.UserServiceClient, producer *kafka.Producer, logger *zap.Logger) *Service {
+	return &Service{
+		repo:     repo,
+		users:    users,
+		producer: producer,
+		logger:   logger,
+	}
+}
+
+func (s *Service) ReportPost(ctx context.Context, req *moderationv1.ReportPostRequest) (*moderationv1.ReportResponse, error) {
+	if req.GetUserId() == 0 || req.GetPostId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and post_id are required")
+	}
+	return s.report(ctx, req.GetUserId(), targetPost, req.GetPostId(), req.GetReason(), req.GetDetails())
+}
+
+func (s *Service) ReportComment(ctx context.Context, req *moderationv1.ReportCommentRequest) (*moderationv1.ReportResponse, error) {
+	if req.GetUserId() == 0 || req.GetCommentId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and comment_id are required")
+	}
+	return s.report(ctx, req.GetUserId(), targetComment, req.GetCommentId(), req.GetReason(), req.GetDetails())
+}
+
+func (s *Service) report(ctx context.Context, userID int64, targetType string, targetID int64, reason moderationv1.ReportReason, details string) (*moderationv1.ReportResponse, error) {
+	severity, ok := reasonSeverity[reason]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+	details = strings.TrimSpace(details)
+	if utf8.RuneCountInString(details) > maxDetailsLength {
+		return nil, status.Errorf(codes.InvalidArgument, "details must not exceed %d characters", maxDetailsLength)
+	}
+
+	target, err := s.repo.GetTarget(ctx, targetType, targetID)
+	if err != nil {
+		if err == ErrTargetNotFound {
+			return nil, status.Errorf(codes.NotFound, "%s not found", targetType)
+		}
+		s.logger.Error("failed to get report target", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to report")
+	}
+	if target.AuthorID == userID {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot report own %s", targetType)
+	}
+
+	reportID, err := s.repo.CreateReport(ctx, NewReport{
+		Target:     target,
+		ReporterID: userID,
+		Reason:     int16(reason),
+		Severity:   severity,
+		Details:    details,
+	})
+	if err != nil {
+		if err == ErrAlreadyReported {
+			return nil, status.Errorf(codes.AlreadyExists, "%s already reported", targetType)
+		}
+		s.logger.Error("failed to create report", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to report")
+	}
+	// жалобы на пост учитываются автоскрытием; жалобщик в событие не попадает
+	if targetType == targetPost {
+		s.publishPostEvent(ctx, &eventsv1.PostEvent{
+			EventType:    eventsv1.EventType_EVENT_TYPE_POST_REPORTED,
+			PostId:       target.PostID,
+			PostAuthorId: target.AuthorID,
+		})
+	}
+
+	return &moderationv1.ReportResponse{ReportId: reportID}, nil
+}
+
+func (s *Service) ListModerationQueue(ctx context.Context, req *moderationv1.ListModerationQueueRequest) (*moderationv1.ListModerationQueueResponse, error) {
+	if req.GetModeratorUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "moderator_user_id is required")
+	}
+	if err := authz.Require(ctx, s.users, req.GetModeratorUserId(), userv1.Permission_PERMISSION_MODERATE_CONTENT); err != nil {
+		return nil, err
+	}
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultQueueSize
+	}
+	if limit > maxQueueSize {
+		limit = maxQueueSize
+	}
+	offset, err := cursor.DecodeOffset(req.GetCursor())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	// автоматические отметки попадают в очередь, когда модератор её открывает
+	if _, err := s.repo.ImportFlags(ctx); err != nil {
+		s.logger.Error("failed to import content flags", zap.Error(err))
+	}
+
+	items, hasMore, err := s.repo.ListQueue(ctx, offset, limit)
+	if err != nil {
+		s.logger.Error("failed to list moderation queue", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list moderation queue")
+	}
+
+	resp := &moderationv1.ListModerationQueueResponse{
+		Items:   make([]*moderationv1.QueueItem, 0, len(items)),
+		HasMore: hasMore,
+	}
+	for _, it := range items {
+		item := &moderationv1.QueueItem{
+			ReportId:        it.ReportID,
+			TargetType:      toProtoTargetType(it.Target.Type),
+			TargetId:        it.Target.ID,
+			PostId:          it.Target.PostID,
+			AuthorUserId:    it.Target.AuthorID,
+			TextPreview:     it.TextPreview,
+			Severity:        int32(it.Severity),
+			ReportCount:     it.ReportCount,
+			FirstReportedAt: it.FirstReportedAt.UTC().Format(time.RFC3339),
+			AuthorStrikes:   it.AuthorStrikes,
+			AutoHidden:      it.AutoHidden,
+		}
+		for _, reason := range it.Reasons {
+			item.Reasons = append(item.Reasons, moderationv1.ReportReason(reason))
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	if hasMore {
+		resp.NextCursor = cursor.EncodeOffset(offset + int32(len(items)))
+	}
+
+	return resp, nil
+}
+
+func (s *Service) ResolveReport(ctx context.Context, req *moderationv1.ResolveReportRequest) (*moderationv1.ResolveReportResponse, error) {
+	if req.GetModeratorUserId() == 0 || req.GetReportId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "moderator_user_id and report_id are required")
+	}
+	var resolution string
+	perm := userv1.Permission_PERMISSION_MODERATE_CONTENT
+	switch req.GetAction() {
+	case moderationv1.ResolutionAction_RESOLUTION_ACTION_DISMISS:
+		resolution = resolutionDismiss
+	case moderationv1.ResolutionAction_RESOLUTION_ACTION_HIDE:
+		resolution = resolutionHide
+	case moderationv1.ResolutionAction_RESOLUTION_ACTION_BAN_AUTHOR:
+		resolution = resolutionBan
+		perm = userv1.Permission_PERMISSION_BAN_USERS
+	default:
+		return nil, status.Error(codes.InvalidArgument, "action is required")
+	}
+	if err := authz.Require(ctx, s.users, req.GetModeratorUserId(), perm); err != nil {
+		return nil, err
+	}
+	note := strings.TrimSpace(req.GetNote())
+	if utf8.RuneCountInString(note) > maxNoteLength {
+		return nil, status.Errorf(codes.InvalidArgument, "note must not exceed %d characters", maxNoteLength)
+	}
+
+	if req.GetBanDurationSeconds() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "ban_duration_seconds must not be negative")
+	}
+
+	// бан выдаётся внутри транзакции решения, после блокировки жалобы: если его не выдали,
+	// решение откатывается, а если не записалось решение — выданный бан снимается
+	var banID int64
+	var banErr error
+	res, err := s.repo.Resolve(ctx, req.GetReportId(), req.GetModeratorUserId(), resolution, note, func(t Target) error {
+		banID, banErr = s.banAuthor(ctx, req, t, note)
+		return banErr
+	})
+	if err != nil {
+		if banErr != nil {
+			return nil, banErr
+		}
+		if banID != 0 {
+			s.revokeBan(ctx, req.GetModeratorUserId(), banID)
+		}
+		if err == ErrReportNotFound {
+			return nil, status.Error(codes.NotFound, "open report not found")
+		}
+		s.logger.Error("failed to resolve report", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to resolve report")
+	}
+
+	switch resolution {
+	case resolutionDismiss:
+		s.publish(ctx, eventsv1.ModerationEventType_MODERATION_EVENT_TYPE_REPORT_DISMISSED, res, req.GetModeratorUserId(), note)
+		if res.Restored {
+			s.publish(ctx, eventsv1.ModerationEventType_MODERATION_EVENT_TYPE_CONTENT_RESTORED, res, req.GetModeratorUserId(), note)
+			s.publishPostEvent(ctx, &eventsv1.PostEvent{
+				EventType:    eventsv1.EventType_EVENT_TYPE_POST_RESTORED,
+				PostId:       res.Target.PostID,
+				PostAuthorId: res.Target.AuthorID,
+			})
+		}
+	case resolutionHide:
+		s.publish(ctx, eventsv1.ModerationEventType_MODERATION_EVENT_TYPE_CONTENT_HIDDEN, res, req.GetModeratorUserId(), note)
+	case resolutionBan:
+		s.publish(ctx, eventsv1.ModerationEventType_MODERATION_EVENT_TYPE_CONTENT_HIDDEN, res, req.GetModeratorUserId(), note)
+		s.publish(ctx, eventsv1.ModerationEventType_MODERATION_EVENT_TYPE_USER_BANNED, res, req.GetModeratorUserId(), note)
+	}
+
+	return &moderationv1.ResolveReportResponse{ResolvedReports: int64(len(res.ReportIDs)), Restored: res.Restored}, nil
+}
+
+// banAuthor блокирует автора объекта жалобы на всё через user-service: там проверяются права
+// модератора, защита модераторов и администраторов от бана и длина причины.
+func (s *Service) banAuthor(ctx context.Context, req *moderationv1.ResolveReportRequest, target Target, reason string) (int64, error) {
+	resp, err := s.users.BanUser(ctx, &userv1.BanUserRequest{
+		ActorUserId:     req.GetModeratorUserId(),
+		TargetUserId:    target.AuthorID,
+		Scope:           userv1.BanScope_BAN_SCOPE_ALL,
+		DurationSeconds: req.GetBanDurationSeconds(),
+		Reason:          reason,
+		Shadow:          req.GetShadowBan(),
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); !ok {
+			return 0, status.Error(codes.Unavailable, "failed to ban author")
+		}
+		return 0, err
+	}
+	return resp.GetBanId(), nil
+}
+
+// revokeBan снимает бан, выданный для решения, которое не удалось записать.
+func (s *Service) revokeBan(ctx context.Context, moderatorID, banID int64) {
+	if _, err := s.users.UnbanUser(context.WithoutCancel(ctx), &userv1.UnbanUserRequest{
+		ActorUserId: moderatorID,
+		BanId:       banID,
+	}); err != nil {
+		s.logger.Error("failed to revoke ban of unresolved report", zap.Int64("ban_id", banID), zap.Error(err))
+	}
+}
+
+func toProtoTargetType(targetType string) moderationv1.TargetType {
+	switch targetType {
+	case targetPost:
+		return moderationv1.TargetType_TARGET_TYPE_POST
+	case targetComment:
+		return moderationv1.TargetType_TARGET_TYPE_COMMENT
+	default:
+		return moderationv1.TargetType_TARGET_TYPE_UNSPECIFIED
+	}
+}
+
+func (s *Service) publish(ctx context.Context, eventType eventsv1.ModerationEventType, res Resolution, moderatorID int64, note string) {
+	if s.producer == nil {
+		return
+	}
+
+	event := &eventsv1.ModerationEvent{
+		EventId:         fmt.Sprintf("mod-%d-%d", res.Target.ID, time.Now().UnixNano()),
+		EventType:       eventType,
+		TargetType:      res.Target.Type,
+		TargetId:        res.Target.ID,
+		PostId:          res.Target.PostID,
+		AuthorUserId:    res.Target.AuthorID,
+		ModeratorUserId: moderatorID,
+		ReportIds:       res.ReportIDs,
+		Note:            note,
+		CreatedAt:       time.Now().UTC().Format(time.RFC3339Nano),
+	}
+
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		s.logger.Error("failed to marshal moderation event", zap.Error(err))
+		return
+	}
+
+	key := []byte(strconv.FormatInt(res.Target.PostID, 10))
+	if err := s.producer.Send(ctx, topicModerationEvents, key, payload); err != nil {
+		s.logger.Error("failed to publish moderation event", zap.Error(err))
+	}
+}
+
+// publishPostEvent отправляет событие поста в post-events, где его читают уведомления и автоскрытие.
+func (s *Service) publishPostEvent(ctx context.Context, event *eventsv1.PostEvent) {
+	if s.producer == nil {
+		return
+	}
+
+	event.EventId = fmt.Sprintf("evt-%d-%d", event.GetPostId(), time.Now().UnixNano())
+	event.CreatedAt = time.Now().UTC().Format(time.RFC3339Nano)
+
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		s.logger.Error("failed to marshal event", zap.Error(err))
+		return
+	}
+
+	key := []byte(strconv.FormatInt(event.GetPostId(), 10))
+	if err := s.producer.Send(ctx, topicPostEvents, key, payload); err != nil {
+		s.logger.Error("failed to publish post event", zap.Error(err))
+	}
+}
@@ -52,22 +52,66 @@ func (h *Handler) Consume(ctx context.Context, msg *sarama.ConsumerMessage) erro
 		return h.handleReaction(ctx, &event, "👎 Новый дизлайк", func(settings *userv1.GetNotificationSettingsResponse) bool {
 			return settings.GetNotifyOnDislike()
 		})
+	case eventsv1.EventType_EVENT_TYPE_VOTE_CHANGED:
+		if event.GetVote() > 0 {
+			return h.handleReaction(ctx, &event, "👍 Дизлайк сменился на лайк", func(settings *userv1.GetNotificationSettingsResponse) bool {
+				return settings.GetNotifyOnLike()
+			})
+		}
+		return h.handleReaction(ctx, &event, "👎 Лайк сменился на дизлайк", func(settings *userv1.GetNotificationSettingsResponse) bool {
+			return settings.GetNotifyOnDislike()
+		})
 	case eventsv1.EventType_EVENT_TYPE_COMMENT_ADDED:
+		// автору поста, которому ответили на комментарий, достаточно уведомления об ответе
+		if event.GetParentCommentId() != 0 && event.GetRecipientUserId() == event.GetPostAuthorId() && event.GetActorUserId() != event.GetPostAuthorId() {
+			return nil
+		}
 		return h.handleReaction(ctx, &event, "💬 Новый комментарий", func(settings *userv1.GetNotificationSettingsResponse) bool {
 			return settings.GetNotifyOnComment()
 		})
+	case eventsv1.EventType_EVENT_TYPE_COMMENT_REPLIED:
+		return h.notify(ctx, event.GetRecipientUserId(), event.GetPostId(), "↩️ Вам ответили на комментарий", func(settings *userv1.GetNotificationSettingsResponse) bool {
+			return settings.GetNotifyOnComment()
+		})
+	case eventsv1.EventType_EVENT_TYPE_COMMENT_LIKE_MILESTONE:
+		title := fmt.Sprintf("🏆 Ваш комментарий набрал %d лайков", event.GetMilestone())
+		return h.notify(ctx, event.GetRecipientUserId(), event.GetPostId(), title, func(settings *userv1.GetNotificationSettingsResponse) bool {
+			return settings.GetNotifyOnLike()
+		})
+	case eventsv1.EventType_EVENT_TYPE_POST_REACTED:
+		return h.handleReaction(ctx, &event, event.GetReaction()+" Новая реакция", func(settings *userv1.GetNotificationSettingsResponse) bool {
+			return settings.GetNotifyOnReaction()
+		})
+	case eventsv1.EventType_EVENT_TYPE_POST_REPOSTED:
+		return h.handleReaction(ctx, &event, "🔁 Ваш пост репостнули", func(*userv1.GetNotificationSettingsResponse) bool {
+			return true
+		})
+	case eventsv1.EventType_EVENT_TYPE_POST_AUTO_HIDDEN:
+		// скрытый пост недоступен через GetPost, поэтому шлём без превью
+		text := fmt.Sprintf("🕵️ Ваш пост #%d скрыт автоматически и отправлен на проверку модераторам.", event.GetPostId())
+		return h.sendToUser(ctx, event.GetPostAuthorId(), text)
+	case eventsv1.EventType_EVENT_TYPE_POST_RESTORED:
+		return h.handleReaction(ctx, &event, "✅ Пост проверен модератором и снова виден", func(*userv1.GetNotificationSettingsResponse) bool {
+			return true
+		})
 	default:
-		// для постов/просмотров уведомлений не шлём
+		// для постов, просмотров, жалоб, снятых голосов и сигналов вовлечённости уведомлений не шлём
 		return nil
 	}
 }
 
 func (h *Handler) handleReaction(ctx context.Context, event *eventsv1.PostEvent, title string, allow func(*userv1.GetNotificationSettingsResponse) bool) error {
-	if event.GetPostAuthorId() == 0 {
+	return h.notify(ctx, event.GetPostAuthorId(), event.GetPostId(), title, allow)
+}
+
+// notify отправляет пользователю уведомление с превью поста, если это разрешено его настройками.
+// Кто совершил действие, в уведомлении не раскрывается.
+func (h *Handler) notify(ctx context.Context, recipientID, postID int64, title string, allow func(*userv1.GetNotificationSettingsResponse) bool) error {
+	if recipientID == 0 {
 		return nil
 	}
 
-	settings, err := h.userClient.GetNotificationSettings(ctx, &userv1.GetNotificationSettingsRequest{UserId: event.GetPostAuthorId()})
+	settings, err := h.userClient.GetNotificationSettings(ctx, &userv1.GetNotificationSettingsRequest{UserId: recipientID})
 	if err != nil {
 		h.logger.Error("failed to get notification settings", zap.Error(err))
 		return nil
@@ -77,7 +121,7 @@ func (h *Handler) handleReaction(ctx context.Context, event *eventsv1.PostEvent,
 		return nil
 	}
 
-	post, err := h.postClient.GetPost(ctx, &postv1.GetPostRequest{PostId: event.GetPostId()})
+	post, err := h.postClient.GetPost(ctx, &postv1.GetPostRequest{PostId: postID})
 	if err != nil {
 		h.logger.Error("failed to fetch post for notification", zap.Error(err))
 		return nil
@@ -85,7 +129,7 @@ func (h *Handler) handleReaction(ctx context.Context, event *eventsv1.PostEvent,
 
 	preview := truncateText(post.GetText(), 80)
 
-	userResp, err := h.userClient.GetUser(ctx, &userv1.GetUserRequest{UserId: post.GetAuthorUserId()})
+	userResp, err := h.userClient.GetUser(ctx, &userv1.GetUserRequest{UserId: recipientID})
 	if err != nil {
 		h.logger.Error("failed to get user telegram id", zap.Error(err))
 		return nil
@@ -104,6 +148,26 @@ func (h *Handler) handleReaction(ctx context.Context, event *eventsv1.PostEvent,
 	return nil
 }
 
+// sendToUser отправляет текст пользователю без превью поста и без учёта настроек —
+// для системных уведомлений.
+func (h *Handler) sendToUser(ctx context.Context, recipientID int64, text string) error {
+	if recipientID == 0 {
+		return nil
+	}
+	userResp, err := h.userClient.GetUser(ctx, &userv1.GetUserRequest{UserId: recipientID})
+	if err != nil {
+		h.logger.Error("failed to get user telegram id", zap.Error(err))
+		return nil
+	}
+	if userResp.GetTelegramId() == 0 {
+		return nil
+	}
+	if err := h.sendTelegram(ctx, userResp.GetTelegramId(), text); err != nil {
+		h.logger.Warn("failed to send telegram notification", zap.Error(err))
+	}
+	return nil
+}
+
 func truncateText(text string, limit int) string {
 	trimmed := strings.TrimSpace(text)
 	if len(trimmed) <= limit {
@@ -0,0 +1,87 @@
+package post
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"strings"
+	"unicode"
+)
+
+// DuplicatePolicy определяет реакцию на повтор недавнего поста.
+type DuplicatePolicy string
+
+const (
+	// DuplicateReject отклоняет пост с AlreadyExists.
+	DuplicateReject DuplicatePolicy = "reject"
+	// DuplicateWarn публикует пост и сообщает автору о повторе в ответе.
+	DuplicateWarn DuplicatePolicy = "warn"
+	// DuplicateFlag публикует пост и молча помечает его для модерации.
+	DuplicateFlag DuplicatePolicy = "flag"
+	// DuplicateOff отключает проверку.
+	DuplicateOff DuplicatePolicy = "off"
+)
+
+// Виды совпадений, возвращаемые клиенту в duplicate_kind.
+const (
+	duplicateKindText     = "text"
+	duplicateKindNearText = "near_text"
+	duplicateKindMedia    = "media"
+)
+
+const (
+	shingleSize = 3
+	// короткие тексты дают слишком похожие отпечатки, для них проверяется только точное совпадение
+	minSimHashWords = 8
+)
+
+// normalizeForHash оставляет только буквы и цифры в нижнем регистре, разделённые одним пробелом,
+// чтобы регистр, пунктуация и эмодзи не спасали копипасту от обнаружения.
+func normalizeForHash(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// contentHash — отпечаток нормализованного текста для поиска точных повторов.
+// Текст без букв и цифр (только эмодзи или пунктуация) отпечатка не получает: иначе все такие
+// посты и подписи считались бы повторами друг друга.
+func contentHash(text string) string {
+	words := normalizeForHash(text)
+	if len(words) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(strings.Join(words, " ")))
+	return hex.EncodeToString(sum[:])
+}
+
+// simHash строит 64-битный SimHash по шинглам из трёх слов. Близкие тексты дают
+// отпечатки с малым расстоянием Хэмминга. false — текст слишком короткий.
+func simHash(text string) (int64, bool) {
+	words := normalizeForHash(text)
+	if len(words) < minSimHashWords {
+		return 0, false
+	}
+
+	var weights [64]int
+	for i := 0; i+shingleSize <= len(words); i++ {
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(words[i:i+shingleSize], " ")))
+		sum := h.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if sum&(1<<bit) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for bit := 0; bit < 64; bit++ {
+		if weights[bit] > 0 {
+			fingerprint |= 1 << bit
+		}
+	}
+	return int64(fingerprint), true
+}
@@ -27,11 +27,149 @@ CREATE TABLE IF NOT EXISTS post_media (
     created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );`
 
+const createTagsTable = `
+CREATE TABLE IF NOT EXISTS post_tags (
+    post_id    BIGINT NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    tag        VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (post_id, tag)
+);`
+
+const createTagsIndex = `
+CREATE INDEX IF NOT EXISTS idx_post_tags_tag_created ON post_tags (tag, created_at DESC);`
+
+const createTagsCreatedIndex = `
+CREATE INDEX IF NOT EXISTS idx_post_tags_created ON post_tags (created_at);`
+
+// search_vector индексирует текст сразу в русской и английской конфигурациях,
+// чтобы стемминг работал для обоих языков без отдельного поля языка.
+const addSearchVectorColumn = `
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        to_tsvector('russian'::regconfig, text) || to_tsvector('english'::regconfig, text)
+    ) STORED;`
+
+const createSearchIndex = `
+CREATE INDEX IF NOT EXISTS idx_posts_search_vector ON posts USING GIN (search_vector);`
+
+const createAuthorPostsIndex = `
+CREATE INDEX IF NOT EXISTS idx_posts_author_created ON posts (author_user_id, created_at DESC, id DESC)
+    WHERE is_deleted = FALSE;`
+
+const addEntitiesColumn = `
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS entities JSONB NOT NULL DEFAULT '[]'::jsonb;`
+
+const createPollsTable = `
+CREATE TABLE IF NOT EXISTS polls (
+    post_id         BIGINT PRIMARY KEY REFERENCES posts(id) ON DELETE CASCADE,
+    multiple_choice BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);`
+
+const createPollOptionsTable = `
+CREATE TABLE IF NOT EXISTS poll_options (
+    id       BIGSERIAL PRIMARY KEY,
+    post_id  BIGINT NOT NULL REFERENCES polls(post_id) ON DELETE CASCADE,
+    position SMALLINT NOT NULL,
+    text     TEXT NOT NULL,
+    UNIQUE (post_id, position)
+);`
+
+const addRepostColumn = `
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS repost_of_post_id BIGINT REFERENCES posts(id) ON DELETE SET NULL;`
+
+const createRepostIndex = `
+CREATE INDEX IF NOT EXISTS idx_posts_repost_of ON posts (repost_of_post_id) WHERE repost_of_post_id IS NOT NULL;`
+
+// один пользователь может сделать только один обычный (без текста) репост поста
+const createPlainRepostUniqueIndex = `
+CREATE UNIQUE INDEX IF NOT EXISTS uq_posts_plain_repost ON posts (author_user_id, repost_of_post_id)
+    WHERE repost_of_post_id IS NOT NULL AND text = '' AND is_deleted = FALSE;`
+
+const createDraftsTable = `
+CREATE TABLE IF NOT EXISTS post_drafts (
+    id                   BIGSERIAL PRIMARY KEY,
+    user_id              BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    text                 TEXT NOT NULL DEFAULT '',
+    entities             JSONB NOT NULL DEFAULT '[]'::jsonb,
+    media_type           VARCHAR(16) NOT NULL DEFAULT '',
+    telegram_file_id     TEXT NOT NULL DEFAULT '',
+    telegram_unique_id   TEXT NOT NULL DEFAULT '',
+    poll_options         TEXT[] NOT NULL DEFAULT '{}',
+    poll_multiple_choice BOOLEAN NOT NULL DEFAULT FALSE,
+    scheduled_at         TIMESTAMPTZ,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);`
+
+const createDraftsUserIndex = `
+CREATE INDEX IF NOT EXISTS idx_post_drafts_user ON post_drafts (user_id, updated_at DESC);`
+
+const createDraftsScheduleIndex = `
+CREATE INDEX IF NOT EXISTS idx_post_drafts_scheduled ON post_drafts (scheduled_at) WHERE scheduled_at IS NOT NULL;`
+
+const addContentHashColumns = `
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS content_hash CHAR(64),
+    ADD COLUMN IF NOT EXISTS simhash BIGINT;`
+
+const createContentHashIndex = `
+CREATE INDEX IF NOT EXISTS idx_posts_content_hash ON posts (content_hash, created_at DESC)
+    WHERE content_hash IS NOT NULL AND is_deleted = FALSE;`
+
+// отпечаток пустого нормализованного текста (sha256 от "") больше не записывается
+const clearEmptyContentHash = `
+UPDATE posts SET content_hash = NULL
+WHERE content_hash = 'e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855';`
+
+const createSimHashIndex = `
+CREATE INDEX IF NOT EXISTS idx_posts_simhash_created ON posts (created_at DESC)
+    WHERE simhash IS NOT NULL AND is_deleted = FALSE;`
+
+const createMediaUniqueIDIndex = `
+CREATE INDEX IF NOT EXISTS idx_post_media_unique_id ON post_media (telegram_unique_id)
+    WHERE telegram_unique_id <> '';`
+
+// post_flags — отметки для модерации, поставленные автоматически при создании или правке поста
+const createPostFlagsTable = `
+CREATE TABLE IF NOT EXISTS post_flags (
+    id         BIGSERIAL PRIMARY KEY,
+    post_id    BIGINT NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    reason     VARCHAR(32) NOT NULL,
+    details    TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);`
+
+const createPostFlagsIndex = `
+CREATE INDEX IF NOT EXISTS idx_post_flags_post ON post_flags (post_id);`
+
 // RunMigrations выполняет минимальный набор миграций для post-service.
 func RunMigrations(ctx context.Context, pool *pgxpool.Pool) error {
 	stmts := []string{
 		createPostsTable,
 		createMediaTable,
+		createTagsTable,
+		createTagsIndex,
+		createTagsCreatedIndex,
+		addSearchVectorColumn,
+		createSearchIndex,
+		createAuthorPostsIndex,
+		addEntitiesColumn,
+		createPollsTable,
+		createPollOptionsTable,
+		addRepostColumn,
+		createRepostIndex,
+		createPlainRepostUniqueIndex,
+		createDraftsTable,
+		createDraftsUserIndex,
+		createDraftsScheduleIndex,
+		addContentHashColumns,
+		createContentHashIndex,
+		clearEmptyContentHash,
+		createSimHashIndex,
+		createMediaUniqueIDIndex,
+		createPostFlagsTable,
+		createPostFlagsIndex,
 	}
 
 	for _, stmt := range stmts {
@@ -4,14 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
+
+	"ghostnet/internal/common/cursor"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var (
 	// ErrPostNotFound возвращается, когда пост не найден.
 	ErrPostNotFound = errors.New("post not found")
+	// ErrNotPostAuthor возвращается, когда пост пытается изменить не его автор.
+	ErrNotPostAuthor = errors.New("not post author")
+	// ErrAlreadyReposted возвращается при повторном обычном репосте того же поста.
+	ErrAlreadyReposted = errors.New("already reposted")
+	// ErrDraftNotFound возвращается, когда черновика нет или он чужой.
+	ErrDraftNotFound = errors.New("draft not found")
+	// ErrTooManyDrafts возвращается при превышении лимита черновиков.
+	ErrTooManyDrafts = errors.New("too many drafts")
 )
 
 // Post описывает сохранённый пост.
@@ -22,8 +34,73 @@ type Post struct {
 	MediaType        string
 	TelegramFileID   string
 	TelegramUniqueID string
+	Tags             []string
+	Entities         []Entity
+	RepostOfPostID   int64
+}
+
+// NewPost — данные для создания поста.
+type NewPost struct {
+	AuthorID         int64
+	Text             string
+	Entities         []Entity
+	MediaType        string
+	TelegramFileID   string
+	TelegramUniqueID string
+	Tags             []string
+	// PollOptions заполняется для MediaType == "poll".
+	PollOptions        []string
+	PollMultipleChoice bool
+	// RepostOfPostID ссылается на оригинал, если пост — репост или цитата.
+	RepostOfPostID int64
+	// DraftID удаляется в той же транзакции, что и создаётся пост.
+	DraftID int64
+	// ContentHash и SimHash — отпечатки текста для поиска повторов; SimHash nil для коротких текстов.
+	ContentHash string
+	SimHash     *int64
+	// Flags записываются в post_flags; модерация ставит их в очередь системными жалобами.
+	Flags []Flag
+}
+
+// Flag — автоматическая отметка поста для модерации.
+type Flag struct {
+	Reason  string
+	Details string
+}
+
+// Duplicate — недавний пост, который повторяет новый.
+type Duplicate struct {
+	PostID int64
+	Kind   string
+}
+
+// DuplicateQuery описывает отпечатки нового поста для FindDuplicate.
+type DuplicateQuery struct {
+	ContentHash      string
+	SimHash          *int64
+	MaxDistance      int
+	TelegramUniqueID string
+	Since            time.Time
 }
 
+// Draft — незаконченный пост пользователя.
+type Draft struct {
+	ID                 int64
+	UserID             int64
+	Text               string
+	Entities           []Entity
+	MediaType          string
+	TelegramFileID     string
+	TelegramUniqueID   string
+	PollOptions        []string
+	PollMultipleChoice bool
+	ScheduledAt        *time.Time
+	UpdatedAt          time.Time
+}
+
+// Опрос хранится в polls, а не в post_media, поэтому тип поста собирается из обеих таблиц.
+const mediaTypeExpr = `COALESCE(m.media_type, CASE WHEN pl.post_id IS NOT NULL THEN 'poll' END, '')`
+
 // PostPreview используется в списках автора.
 type PostPreview struct {
 	PostID      int64
@@ -32,6 +109,31 @@ type PostPreview struct {
 	Dislikes    int64
 	Comments    int64
 	Views       int64
+	Saves       int64
+	CreatedAt   time.Time
+}
+
+// PostSummary — краткая карточка поста для подборок по тегу.
+type PostSummary struct {
+	PostID      int64
+	TextPreview string
+	MediaType   string
+	CreatedAt   time.Time
+}
+
+// TagCount — число постов с тегом за окно.
+type TagCount struct {
+	Tag   string
+	Posts int64
+}
+
+// SearchHit — найденный пост с подсвеченным фрагментом.
+type SearchHit struct {
+	PostID    int64
+	Snippet   string
+	Rank      float64
+	MediaType string
+	CreatedAt time.Time
 }
 
 type Repository struct {
@@ -42,31 +144,74 @@ func NewRepository(pool *pgxpool.Pool) *Repository {
 	return &Repository{pool: pool}
 }
 
-func (r *Repository) CreatePost(ctx context.Context, authorID int64, text string, mediaType string, fileID string, uniqueID string) (int64, error) {
+func (r *Repository) CreatePost(ctx context.Context, post NewPost) (int64, error) {
 	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return 0, fmt.Errorf("begin tx: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
+	var repostOf *int64
+	if post.RepostOfPostID != 0 {
+		repostOf = &post.RepostOfPostID
+	}
+
 	var postID int64
 	if err := tx.QueryRow(ctx, `
-		INSERT INTO posts (author_user_id, text)
-		VALUES ($1, $2)
+		INSERT INTO posts (author_user_id, text, entities, repost_of_post_id, content_hash, simhash)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6)
 		RETURNING id
-	`, authorID, text).Scan(&postID); err != nil {
+	`, post.AuthorID, post.Text, entitiesOrEmpty(post.Entities), repostOf, post.ContentHash, post.SimHash).Scan(&postID); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "uq_posts_plain_repost" {
+			return 0, ErrAlreadyReposted
+		}
 		return 0, fmt.Errorf("insert post: %w", err)
 	}
 
-	if mediaType != "" && fileID != "" {
+	if post.DraftID != 0 {
+		tag, err := tx.Exec(ctx, `DELETE FROM post_drafts WHERE id = $1 AND user_id = $2`, post.DraftID, post.AuthorID)
+		if err != nil {
+			return 0, fmt.Errorf("delete draft: %w", err)
+		}
+		// черновик уже опубликован параллельным запросом
+		if tag.RowsAffected() == 0 {
+			return 0, ErrDraftNotFound
+		}
+	}
+
+	if post.MediaType != "" && post.TelegramFileID != "" {
 		if _, err := tx.Exec(ctx, `
 			INSERT INTO post_media (post_id, telegram_file_id, telegram_unique_id, media_type)
 			VALUES ($1, $2, $3, $4)
-		`, postID, fileID, uniqueID, mediaType); err != nil {
+		`, postID, post.TelegramFileID, post.TelegramUniqueID, post.MediaType); err != nil {
 			return 0, fmt.Errorf("insert media: %w", err)
 		}
 	}
 
+	if post.MediaType == "poll" {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO polls (post_id, multiple_choice) VALUES ($1, $2)
+		`, postID, post.PollMultipleChoice); err != nil {
+			return 0, fmt.Errorf("insert poll: %w", err)
+		}
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO poll_options (post_id, position, text)
+			SELECT $1, o.position, o.text
+			FROM UNNEST($2::text[]) WITH ORDINALITY AS o(text, position)
+		`, postID, post.PollOptions); err != nil {
+			return 0, fmt.Errorf("insert poll options: %w", err)
+		}
+	}
+
+	if err := replaceTags(ctx, tx, postID, post.Tags); err != nil {
+		return 0, err
+	}
+
+	if err := insertPostFlags(ctx, tx, postID, post.Flags); err != nil {
+		return 0, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return 0, fmt.Errorf("commit: %w", err)
 	}
@@ -74,29 +219,318 @@ func (r *Repository) CreatePost(ctx context.Context, authorID int64, text string
 	return postID, nil
 }
 
+// FindDuplicate ищет среди неудалённых постов после q.Since повтор медиа, точный повтор
+// текста или близкий по SimHash текст — именно в таком порядке.
+func (r *Repository) FindDuplicate(ctx context.Context, q DuplicateQuery) (Duplicate, bool, error) {
+	var d Duplicate
+
+	if q.TelegramUniqueID != "" {
+		err := r.pool.QueryRow(ctx, `
+			SELECT p.id
+			FROM post_media m
+			JOIN posts p ON p.id = m.post_id
+			WHERE m.telegram_unique_id = $1 AND p.is_deleted = FALSE AND p.created_at >= $2
+			ORDER BY p.created_at DESC
+			LIMIT 1
+		`, q.TelegramUniqueID, q.Since).Scan(&d.PostID)
+		if err == nil {
+			d.Kind = duplicateKindMedia
+			return d, true, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return d, false, fmt.Errorf("find media duplicate: %w", err)
+		}
+	}
+
+	if q.ContentHash != "" {
+		err := r.pool.QueryRow(ctx, `
+			SELECT id FROM posts
+			WHERE content_hash = $1 AND is_deleted = FALSE AND created_at >= $2
+			ORDER BY created_at DESC
+			LIMIT 1
+		`, q.ContentHash, q.Since).Scan(&d.PostID)
+		if err == nil {
+			d.Kind = duplicateKindText
+			return d, true, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return d, false, fmt.Errorf("find text duplicate: %w", err)
+		}
+	}
+
+	if q.SimHash != nil && q.MaxDistance > 0 {
+		err := r.pool.QueryRow(ctx, `
+			SELECT id FROM posts
+			WHERE simhash IS NOT NULL AND is_deleted = FALSE AND created_at >= $2
+			  AND bit_count((simhash # $1)::bit(64)) <= $3
+			ORDER BY created_at DESC
+			LIMIT 1
+		`, *q.SimHash, q.Since, q.MaxDistance).Scan(&d.PostID)
+		if err == nil {
+			d.Kind = duplicateKindNearText
+			return d, true, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return d, false, fmt.Errorf("find near duplicate: %w", err)
+		}
+	}
+
+	return d, false, nil
+}
+
 func (r *Repository) GetPost(ctx context.Context, postID int64) (Post, error) {
 	var p Post
 	err := r.pool.QueryRow(ctx, `
 		SELECT p.id,
 		       p.author_user_id,
 		       p.text,
-		       COALESCE(m.media_type, ''),
+		       `+mediaTypeExpr+`,
 		       COALESCE(m.telegram_file_id, ''),
-		       COALESCE(m.telegram_unique_id, '')
+		       COALESCE(m.telegram_unique_id, ''),
+		       p.entities,
+		       COALESCE(p.repost_of_post_id, 0)
 		FROM posts p
 		LEFT JOIN post_media m ON m.post_id = p.id
+		LEFT JOIN polls pl ON pl.post_id = p.id
 		WHERE p.id = $1 AND p.is_deleted = FALSE
-	`, postID).Scan(&p.ID, &p.AuthorUserID, &p.Text, &p.MediaType, &p.TelegramFileID, &p.TelegramUniqueID)
+	`, postID).Scan(&p.ID, &p.AuthorUserID, &p.Text, &p.MediaType, &p.TelegramFileID, &p.TelegramUniqueID, &p.Entities, &p.RepostOfPostID)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return p, ErrPostNotFound
 	}
 	if err != nil {
 		return p, fmt.Errorf("get post: %w", err)
 	}
+
+	rows, err := r.pool.Query(ctx, `SELECT tag FROM post_tags WHERE post_id = $1 ORDER BY tag`, postID)
+	if err != nil {
+		return p, fmt.Errorf("get post tags: %w", err)
+	}
+	p.Tags, err = pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return p, fmt.Errorf("scan post tags: %w", err)
+	}
 	return p, nil
 }
 
-func (r *Repository) ListUserPosts(ctx context.Context, userID int64, page, pageSize int32) ([]PostPreview, bool, error) {
+// BatchGetPosts загружает живые посты одним запросом. Отсутствующие и удалённые
+// идентификаторы просто не попадают в результат.
+func (r *Repository) BatchGetPosts(ctx context.Context, postIDs []int64) (map[int64]Post, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT p.id,
+		       p.author_user_id,
+		       p.text,
+		       `+mediaTypeExpr+`,
+		       COALESCE(m.telegram_file_id, ''),
+		       COALESCE(m.telegram_unique_id, ''),
+		       COALESCE((SELECT array_agg(t.tag ORDER BY t.tag) FROM post_tags t WHERE t.post_id = p.id), '{}'),
+		       p.entities,
+		       COALESCE(p.repost_of_post_id, 0)
+		FROM posts p
+		LEFT JOIN post_media m ON m.post_id = p.id
+		LEFT JOIN polls pl ON pl.post_id = p.id
+		WHERE p.id = ANY($1) AND p.is_deleted = FALSE
+	`, postIDs)
+	if err != nil {
+		return nil, fmt.Errorf("batch get posts: %w", err)
+	}
+	defer rows.Close()
+
+	posts := make(map[int64]Post, len(postIDs))
+	for rows.Next() {
+		var p Post
+		if err := rows.Scan(&p.ID, &p.AuthorUserID, &p.Text, &p.MediaType, &p.TelegramFileID, &p.TelegramUniqueID, &p.Tags, &p.Entities, &p.RepostOfPostID); err != nil {
+			return nil, fmt.Errorf("scan post: %w", err)
+		}
+		posts[p.ID] = p
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate posts: %w", err)
+	}
+	return posts, nil
+}
+
+// EditPost меняет текст поста, пересобирает его теги и записывает отметки фильтра.
+// Править может только автор.
+func (r *Repository) EditPost(ctx context.Context, authorID, postID int64, text string, entities []Entity, tags []string, hash string, simhash *int64, flags []Flag) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var ownerID int64
+	err = tx.QueryRow(ctx, `
+		SELECT author_user_id FROM posts
+		WHERE id = $1 AND is_deleted = FALSE
+		FOR UPDATE
+	`, postID).Scan(&ownerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrPostNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("lock post: %w", err)
+	}
+	if ownerID != authorID {
+		return ErrNotPostAuthor
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE posts SET text = $2, entities = $3, content_hash = NULLIF($4, ''), simhash = $5, updated_at = NOW()
+		WHERE id = $1
+	`, postID, text, entitiesOrEmpty(entities), hash, simhash); err != nil {
+		return fmt.Errorf("update post: %w", err)
+	}
+
+	if err := replaceTags(ctx, tx, postID, tags); err != nil {
+		return err
+	}
+
+	if err := insertPostFlags(ctx, tx, postID, flags); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	return nil
+}
+
+func insertPostFlags(ctx context.Context, tx pgx.Tx, postID int64, flags []Flag) error {
+	for _, f := range flags {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO post_flags (post_id, reason, details) VALUES ($1, $2, $3)
+		`, postID, f.Reason, f.Details); err != nil {
+			return fmt.Errorf("insert flag: %w", err)
+		}
+	}
+	return nil
+}
+
+// entitiesOrEmpty не даёт записать JSON null в NOT NULL колонку.
+func entitiesOrEmpty(entities []Entity) []Entity {
+	if entities == nil {
+		return []Entity{}
+	}
+	return entities
+}
+
+// replaceTags приводит набор тегов поста к tags. У сохранившихся тегов остаётся
+// исходный created_at, чтобы правка не накручивала тренды.
+func replaceTags(ctx context.Context, tx pgx.Tx, postID int64, tags []string) error {
+	if tags == nil {
+		tags = []string{}
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM post_tags
+		WHERE post_id = $1 AND NOT (tag = ANY($2::text[]))
+	`, postID, tags); err != nil {
+		return fmt.Errorf("delete stale tags: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO post_tags (post_id, tag)
+		SELECT $1, UNNEST($2::text[])
+		ON CONFLICT (post_id, tag) DO NOTHING
+	`, postID, tags); err != nil {
+		return fmt.Errorf("insert tags: %w", err)
+	}
+	return nil
+}
+
+// ListPostsByTag возвращает посты с тегом от новых к старым, начиная после курсора.
+func (r *Repository) ListPostsByTag(ctx context.Context, tag string, after *cursor.Cursor, limit int32) ([]PostSummary, bool, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+
+	var afterTime *time.Time
+	var afterID int64
+	if after != nil {
+		afterTime, afterID = &after.CreatedAt, after.ID
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT p.id,
+		       LEFT(p.text, 64) AS preview,
+		       `+mediaTypeExpr+`,
+		       p.created_at
+		FROM post_tags t
+		JOIN posts p ON p.id = t.post_id
+		LEFT JOIN post_media m ON m.post_id = p.id
+		LEFT JOIN polls pl ON pl.post_id = p.id
+		WHERE t.tag = $1
+		  AND p.is_deleted = FALSE
+		  AND `+visibleAuthor+`
+		  AND ($2::timestamptz IS NULL OR (p.created_at, p.id) < ($2, $3))
+		ORDER BY p.created_at DESC, p.id DESC
+		LIMIT $4
+	`, tag, afterTime, afterID, limit+1)
+	if err != nil {
+		return nil, false, fmt.Errorf("list posts by tag: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]PostSummary, 0, limit)
+	for rows.Next() {
+		var item PostSummary
+		if err := rows.Scan(&item.PostID, &item.TextPreview, &item.MediaType, &item.CreatedAt); err != nil {
+			return nil, false, fmt.Errorf("scan tagged post: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("iterate tagged posts: %w", err)
+	}
+
+	hasMore := false
+	if int32(len(items)) > limit {
+		hasMore = true
+		items = items[:limit]
+	}
+
+	return items, hasMore, nil
+}
+
+// ListTrendingTags считает, сколько живых постов получили тег за последнее окно.
+func (r *Repository) ListTrendingTags(ctx context.Context, window time.Duration, limit int32) ([]TagCount, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT t.tag, COUNT(*) AS posts
+		FROM post_tags t
+		JOIN posts p ON p.id = t.post_id
+		WHERE t.created_at >= NOW() - make_interval(secs => $1)
+		  AND p.is_deleted = FALSE
+		  AND `+visibleAuthor+`
+		GROUP BY t.tag
+		ORDER BY posts DESC, t.tag
+		LIMIT $2
+	`, window.Seconds(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("list trending tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make([]TagCount, 0, limit)
+	for rows.Next() {
+		var item TagCount
+		if err := rows.Scan(&item.Tag, &item.Posts); err != nil {
+			return nil, fmt.Errorf("scan trending tag: %w", err)
+		}
+		tags = append(tags, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate trending tags: %w", err)
+	}
+	return tags, nil
+}
+
+// ListUserPosts возвращает посты автора от новых к старым. Если задан after,
+// страница строится по ключу (created_at, id), иначе — по устаревшему page/OFFSET.
+func (r *Repository) ListUserPosts(ctx context.Context, userID int64, page, pageSize int32, after *cursor.Cursor) ([]PostPreview, bool, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -107,39 +541,30 @@ func (r *Repository) ListUserPosts(ctx context.Context, userID int64, page, page
 	offset := (page - 1) * pageSize
 	limit := pageSize + 1 // на один больше для определения has_more
 
+	var afterTime *time.Time
+	var afterID int64
+	if after != nil {
+		afterTime, afterID = &after.CreatedAt, after.ID
+		offset = 0
+	}
+
+	// счётчики ведёт interaction-service в post_counters; у поста без активности строки может не быть
 	rows, err := r.pool.Query(ctx, `
-		WITH votes AS (
-			SELECT post_id,
-				   COUNT(*) FILTER (WHERE vote_type = 1)  AS likes,
-				   COUNT(*) FILTER (WHERE vote_type = -1) AS dislikes
-			FROM post_votes
-			GROUP BY post_id
-		),
-		comments AS (
-			SELECT post_id, COUNT(*) AS comments
-			FROM post_comments
-			WHERE is_deleted = FALSE
-			GROUP BY post_id
-		),
-		views AS (
-			SELECT post_id, COUNT(*) AS views
-			FROM post_views
-			GROUP BY post_id
-		)
 		SELECT p.id,
 		       LEFT(p.text, 64) AS preview,
-		       COALESCE(v.likes, 0),
-		       COALESCE(v.dislikes, 0),
-		       COALESCE(c.comments, 0),
-		       COALESCE(vw.views, 0)
+		       COALESCE(pc.likes, 0),
+		       COALESCE(pc.dislikes, 0),
+		       COALESCE(pc.comments, 0),
+		       COALESCE(pc.views, 0),
+		       COALESCE(pc.saves, 0),
+		       p.created_at
 		FROM posts p
-		LEFT JOIN votes v ON v.post_id = p.id
-		LEFT JOIN comments c ON c.post_id = p.id
-		LEFT JOIN views vw ON vw.post_id = p.id
+		LEFT JOIN post_counters pc ON pc.post_id = p.id
 		WHERE p.author_user_id = $1 AND p.is_deleted = FALSE
-		ORDER BY p.created_at DESC
+		  AND ($4::timestamptz IS NULL OR (p.created_at, p.id) < ($4, $5))
+		ORDER BY p.created_at DESC, p.id DESC
 		LIMIT $2 OFFSET $3
-	`, userID, limit, offset)
+	`, userID, limit, offset, afterTime, afterID)
 	if err != nil {
 		return nil, false, fmt.Errorf("list posts: %w", err)
 	}
@@ -148,7 +573,7 @@ func (r *Repository) ListUserPosts(ctx context.Context, userID int64, page, page
 	previews := make([]PostPreview, 0, pageSize)
 	for rows.Next() {
 		var item PostPreview
-		if err := rows.Scan(&item.PostID, &item.TextPreview, &item.Likes, &item.Dislikes, &item.Comments, &item.Views); err != nil {
+		if err := rows.Scan(&item.PostID, &item.TextPreview, &item.Likes, &item.Dislikes, &item.Comments, &item.Views, &item.Saves, &item.CreatedAt); err != nil {
 			return nil, false, fmt.Errorf("scan post: %w", err)
 		}
 		previews = append(previews, item)
@@ -162,3 +587,207 @@ func (r *Repository) ListUserPosts(ctx context.Context, userID int64, page, page
 
 	return previews, hasMore, nil
 }
+
+// visibleAuthor — условие «автор поста p не под теневым баном на публикацию»: такие посты
+// видит только сам автор, поэтому их нет ни в ленте, ни в поиске, ни в выдаче по тегу.
+const visibleAuthor = `NOT EXISTS (
+		SELECT 1 FROM user_bans b
+		WHERE b.user_id = p.author_user_id AND b.shadow AND b.revoked_at IS NULL
+		  AND (b.expires_at IS NULL OR b.expires_at > NOW())
+		  AND b.scope IN ('posting', 'all'))`
+
+// searchHeadlineOptions — параметры ts_headline для сниппетов поиска.
+const searchHeadlineOptions = "StartSel=«, StopSel=», MaxWords=30, MinWords=10, MaxFragments=2"
+
+// SearchPosts ищет посты по тексту. Релевантность ts_rank домножается на логарифм
+// вовлечённости, чтобы обсуждаемые посты поднимались выше при равном совпадении.
+// Сниппет строится в той конфигурации, в которой совпал текст.
+func (r *Repository) SearchPosts(ctx context.Context, query string, offset, limit int32) ([]SearchHit, bool, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		WITH q AS (
+			SELECT websearch_to_tsquery('russian', $1) AS ru,
+			       websearch_to_tsquery('english', $1) AS en,
+			       websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS query
+		),
+		matched AS (
+			SELECT p.id, p.text, p.created_at, ts_rank(p.search_vector, q.query) AS text_rank
+			FROM posts p, q
+			WHERE p.search_vector @@ q.query
+			  AND p.is_deleted = FALSE
+			  AND `+visibleAuthor+`
+		),
+		scored AS (
+			SELECT m.*,
+			       m.text_rank * (1 + 0.1 * LN(1 + GREATEST(COALESCE(pc.likes, 0) - COALESCE(pc.dislikes, 0), 0) + COALESCE(pc.comments, 0))) AS rank
+			FROM matched m
+			LEFT JOIN post_counters pc ON pc.post_id = m.id
+			ORDER BY rank DESC, m.id DESC
+			LIMIT $3 OFFSET $2
+		)
+		SELECT s.id,
+		       CASE WHEN to_tsvector('russian', s.text) @@ q.ru
+		            THEN ts_headline('russian', s.text, q.ru, $4)
+		            ELSE ts_headline('english', s.text, q.en, $4)
+		       END,
+		       s.rank,
+		       `+mediaTypeExpr+`,
+		       s.created_at
+		FROM scored s
+		CROSS JOIN q
+		LEFT JOIN post_media m ON m.post_id = s.id
+		LEFT JOIN polls pl ON pl.post_id = s.id
+		ORDER BY s.rank DESC, s.id DESC
+	`, query, offset, limit+1, searchHeadlineOptions)
+	if err != nil {
+		return nil, false, fmt.Errorf("search posts: %w", err)
+	}
+	defer rows.Close()
+
+	hits := make([]SearchHit, 0, limit)
+	for rows.Next() {
+		var hit SearchHit
+		if err := rows.Scan(&hit.PostID, &hit.Snippet, &hit.Rank, &hit.MediaType, &hit.CreatedAt); err != nil {
+			return nil, false, fmt.Errorf("scan search hit: %w", err)
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("iterate search hits: %w", err)
+	}
+
+	hasMore := false
+	if int32(len(hits)) > limit {
+		hasMore = true
+		hits = hits[:limit]
+	}
+
+	return hits, hasMore, nil
+}
+
+const draftColumns = `id, user_id, text, entities, media_type, telegram_file_id, telegram_unique_id,
+	poll_options, poll_multiple_choice, scheduled_at, updated_at`
+
+func scanDraft(row pgx.Row) (Draft, error) {
+	var d Draft
+	err := row.Scan(&d.ID, &d.UserID, &d.Text, &d.Entities, &d.MediaType, &d.TelegramFileID, &d.TelegramUniqueID,
+		&d.PollOptions, &d.PollMultipleChoice, &d.ScheduledAt, &d.UpdatedAt)
+	return d, err
+}
+
+// SaveDraft создаёт черновик (ID == 0) или перезаписывает существующий черновик пользователя.
+func (r *Repository) SaveDraft(ctx context.Context, d Draft, maxDrafts int) (int64, error) {
+	if d.PollOptions == nil {
+		d.PollOptions = []string{}
+	}
+
+	var id int64
+	var err error
+	if d.ID == 0 {
+		err = r.pool.QueryRow(ctx, `
+			INSERT INTO post_drafts (user_id, text, entities, media_type, telegram_file_id, telegram_unique_id,
+			                         poll_options, poll_multiple_choice, scheduled_at)
+			SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9
+			WHERE (SELECT COUNT(*) FROM post_drafts WHERE user_id = $1) < $10
+			RETURNING id
+		`, d.UserID, d.Text, entitiesOrEmpty(d.Entities), d.MediaType, d.TelegramFileID, d.TelegramUniqueID,
+			d.PollOptions, d.PollMultipleChoice, d.ScheduledAt, maxDrafts).Scan(&id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrTooManyDrafts
+		}
+	} else {
+		err = r.pool.QueryRow(ctx, `
+			UPDATE post_drafts
+			SET text = $3, entities = $4, media_type = $5, telegram_file_id = $6, telegram_unique_id = $7,
+			    poll_options = $8, poll_multiple_choice = $9, scheduled_at = $10, updated_at = NOW()
+			WHERE id = $1 AND user_id = $2
+			RETURNING id
+		`, d.ID, d.UserID, d.Text, entitiesOrEmpty(d.Entities), d.MediaType, d.TelegramFileID, d.TelegramUniqueID,
+			d.PollOptions, d.PollMultipleChoice, d.ScheduledAt).Scan(&id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrDraftNotFound
+		}
+	}
+	if err != nil {
+		return 0, fmt.Errorf("save draft: %w", err)
+	}
+	return id, nil
+}
+
+func (r *Repository) GetDraft(ctx context.Context, userID, draftID int64) (Draft, error) {
+	d, err := scanDraft(r.pool.QueryRow(ctx, `
+		SELECT `+draftColumns+`
+		FROM post_drafts
+		WHERE id = $1 AND user_id = $2
+	`, draftID, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return d, ErrDraftNotFound
+	}
+	if err != nil {
+		return d, fmt.Errorf("get draft: %w", err)
+	}
+	return d, nil
+}
+
+func (r *Repository) ListDrafts(ctx context.Context, userID int64) ([]Draft, error) {
+	return r.queryDrafts(ctx, `
+		SELECT `+draftColumns+`
+		FROM post_drafts
+		WHERE user_id = $1
+		ORDER BY updated_at DESC, id DESC
+	`, userID)
+}
+
+// DueDrafts возвращает черновики, время публикации которых наступило.
+func (r *Repository) DueDrafts(ctx context.Context, limit int) ([]Draft, error) {
+	return r.queryDrafts(ctx, `
+		SELECT `+draftColumns+`
+		FROM post_drafts
+		WHERE scheduled_at IS NOT NULL AND scheduled_at <= NOW()
+		ORDER BY scheduled_at
+		LIMIT $1
+	`, limit)
+}
+
+func (r *Repository) queryDrafts(ctx context.Context, query string, args ...any) ([]Draft, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list drafts: %w", err)
+	}
+	defer rows.Close()
+
+	var drafts []Draft
+	for rows.Next() {
+		d, err := scanDraft(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan draft: %w", err)
+		}
+		drafts = append(drafts, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate drafts: %w", err)
+	}
+	return drafts, nil
+}
+
+// ClearDraftSchedule снимает расписание, например если черновик не прошёл проверку при публикации.
+func (r *Repository) ClearDraftSchedule(ctx context.Context, draftID int64) error {
+	if _, err := r.pool.Exec(ctx, `UPDATE post_drafts SET scheduled_at = NULL WHERE id = $1`, draftID); err != nil {
+		return fmt.Errorf("clear draft schedule: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) DeleteDraft(ctx context.Context, userID, draftID int64) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM post_drafts WHERE id = $1 AND user_id = $2`, draftID, userID)
+	if err != nil {
+		return fmt.Errorf("delete draft: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrDraftNotFound
+	}
+	return nil
+}
@@ -9,6 +9,12 @@ import (
 
 	eventsv1 "ghostnet/gen/go/proto/events/v1"
 	postv1 "ghostnet/gen/go/proto/post/v1"
+	userv1 "ghostnet/gen/go/proto/user/v1"
+	"ghostnet/internal/common/authz"
+	"ghostnet/internal/common/contentfilter"
+	"ghostnet/internal/common/cursor"
+	"ghostnet/internal/common/hashtag"
+	"ghostnet/internal/common/ids"
 	"ghostnet/internal/common/kafka"
 
 	"go.uber.org/zap"
@@ -19,50 +25,227 @@ import (
 
 const (
 	topicPostEvents = "post-events"
+
+	defaultTrendingWindow = 24 * time.Hour
+	maxTrendingWindow     = 30 * 24 * time.Hour
+	maxPageLimit          = 50
+
+	maxBatchSize = 100
+
+	maxDraftsPerUser     = 20
+	maxScheduleAhead     = 30 * 24 * time.Hour
+	draftSchedulerBatch  = 50
+	maxSearchQueryLength = 256
+	maxSearchOffset      = 1000
 )
 
+// Options — настраиваемое поведение PostService.
+type Options struct {
+	// DuplicatePolicy — что делать с повтором недавнего поста.
+	DuplicatePolicy DuplicatePolicy
+	// DuplicateWindow — за какой период искать повторы.
+	DuplicateWindow time.Duration
+	// NearDuplicateDistance — максимальное расстояние Хэмминга между SimHash; 0 отключает нечёткий поиск.
+	NearDuplicateDistance int
+	// ContentFilter проверяет текст перед сохранением; nil отключает проверку.
+	ContentFilter *contentfilter.Filter
+}
+
 // Service реализует gRPC PostService.
 type Service struct {
 	postv1.UnimplementedPostServiceServer
 	repo     *Repository
+	users    userv1.UserServiceClient
 	producer *kafka.Producer
+	opts     Options
 	logger   *zap.Logger
 }
 
-func NewService(repo *Repository, producer *kafka.Producer, logger *zap.Logger) *Service {
+// NewService создаёт PostService; через users проверяются баны авторов.
+func NewService(repo *Repository, users userv1.UserServiceClient, producer *kafka.Producer, opts Options, logger *zap.Logger) *Service {
 	return &Service{
 		repo:     repo,
+		users:    users,
 		producer: producer,
+		opts:     opts,
 		logger:   logger,
 	}
 }
 
 func (s *Service) CreatePost(ctx context.Context, req *postv1.CreatePostRequest) (*postv1.CreatePostResponse, error) {
+	return s.createPost(ctx, req, 0)
+}
+
+// createPost проверяет и сохраняет пост и публикует POST_CREATED. Общий путь для
+// CreatePost и публикации черновиков; draftID удаляется вместе с созданием поста.
+func (s *Service) createPost(ctx context.Context, req *postv1.CreatePostRequest, draftID int64) (*postv1.CreatePostResponse, error) {
 	if req.GetAuthorUserId() == 0 {
 		return nil, status.Error(codes.InvalidArgument, "author_user_id is required")
 	}
-	text := strings.TrimSpace(req.GetText())
-	if text == "" {
-		return nil, status.Error(codes.InvalidArgument, "text is required")
+	// теневой бан не мешает публикации: такие посты не попадают в чужую ленту
+	if _, err := authz.CheckBan(ctx, s.users, req.GetAuthorUserId(), userv1.BanScope_BAN_SCOPE_POSTING); err != nil {
+		return nil, err
 	}
-
 	mediaType := strings.TrimSpace(req.GetMediaType())
-	if mediaType != "" && mediaType != "photo" && mediaType != "video" {
-		return nil, status.Error(codes.InvalidArgument, "media_type must be empty, photo or video")
+	if mediaType != "" && mediaType != "photo" && mediaType != "video" && mediaType != "poll" {
+		return nil, status.Error(codes.InvalidArgument, "media_type must be empty, photo, video or poll")
 	}
-	if mediaType != "" && strings.TrimSpace(req.GetTelegramFileId()) == "" {
+	if (mediaType == "photo" || mediaType == "video") && strings.TrimSpace(req.GetTelegramFileId()) == "" {
 		return nil, status.Error(codes.InvalidArgument, "telegram_file_id is required when media_type set")
 	}
 
-	postID, err := s.repo.CreatePost(ctx, req.GetAuthorUserId(), text, mediaType, req.GetTelegramFileId(), req.GetTelegramUniqueId())
+	text, entities, err := validateContent(req.GetText(), req.GetEntities(), mediaType)
+	if err != nil {
+		return nil, err
+	}
+
+	var pollOptions []string
+	if mediaType == "poll" {
+		if pollOptions, err = validatePollOptions(req.GetPollOptions()); err != nil {
+			return nil, err
+		}
+	} else if len(req.GetPollOptions()) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "poll_options require media_type poll")
+	}
+
+	filtered := s.opts.ContentFilter.Check(strings.Join(append([]string{text}, pollOptions...), "\n"))
+	if hit, rejected := filtered.Rejected(); rejected {
+		return nil, status.Errorf(codes.InvalidArgument, "content rejected: %s", hit.Detail)
+	}
+	var flags []Flag
+	for _, hit := range filtered.Flags() {
+		flags = append(flags, Flag{Reason: "filter:" + hit.Rule, Details: hit.Detail})
+	}
+
+	hash := contentHash(text)
+	var simhash *int64
+	if h, ok := simHash(text); ok {
+		simhash = &h
+	}
+
+	resp := &postv1.CreatePostResponse{}
+	if s.opts.DuplicatePolicy != DuplicateOff {
+		dup, found, err := s.repo.FindDuplicate(ctx, DuplicateQuery{
+			ContentHash:      hash,
+			SimHash:          simhash,
+			MaxDistance:      s.opts.NearDuplicateDistance,
+			TelegramUniqueID: req.GetTelegramUniqueId(),
+			Since:            time.Now().Add(-s.opts.DuplicateWindow),
+		})
+		if err != nil {
+			// проверка повторов не должна мешать публикации
+			s.logger.Error("failed to check duplicates", zap.Error(err))
+		} else if found {
+			switch s.opts.DuplicatePolicy {
+			case DuplicateReject:
+				return nil, status.Errorf(codes.AlreadyExists, "duplicate of recent post %d", dup.PostID)
+			case DuplicateFlag:
+				flags = append(flags, Flag{
+					Reason:  "duplicate",
+					Details: fmt.Sprintf("%s duplicate of post %d", dup.Kind, dup.PostID),
+				})
+			default:
+				resp.DuplicateOfPostId, resp.DuplicateKind = dup.PostID, dup.Kind
+			}
+		}
+	}
+
+	postID, err := s.repo.CreatePost(ctx, NewPost{
+		AuthorID:           req.GetAuthorUserId(),
+		Text:               text,
+		Entities:           entities,
+		MediaType:          mediaType,
+		TelegramFileID:     req.GetTelegramFileId(),
+		TelegramUniqueID:   req.GetTelegramUniqueId(),
+		Tags:               hashtag.Extract(text),
+		PollOptions:        pollOptions,
+		PollMultipleChoice: req.GetPollMultipleChoice(),
+		DraftID:            draftID,
+		ContentHash:        hash,
+		SimHash:            simhash,
+		Flags:              flags,
+	})
 	if err != nil {
+		if err == ErrDraftNotFound {
+			return nil, status.Error(codes.NotFound, "draft not found")
+		}
 		s.logger.Error("failed to create post", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to create post")
 	}
 
 	s.publishPostCreated(ctx, postID, req.GetAuthorUserId())
 
-	return &postv1.CreatePostResponse{PostId: postID}, nil
+	resp.PostId = postID
+	return resp, nil
+}
+
+func (s *Service) RepostPost(ctx context.Context, req *postv1.RepostPostRequest) (*postv1.RepostPostResponse, error) {
+	if req.GetUserId() == 0 || req.GetPostId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and post_id are required")
+	}
+	if _, err := authz.CheckBan(ctx, s.users, req.GetUserId(), userv1.BanScope_BAN_SCOPE_POSTING); err != nil {
+		return nil, err
+	}
+
+	original, err := s.repo.GetPost(ctx, req.GetPostId())
+	if err != nil {
+		if err == ErrPostNotFound {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+		s.logger.Error("failed to get original post", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to repost")
+	}
+	// репост репоста без текста указывает сразу на первоисточник
+	if original.RepostOfPostID != 0 && original.Text == "" {
+		if original, err = s.repo.GetPost(ctx, original.RepostOfPostID); err != nil {
+			if err == ErrPostNotFound {
+				return nil, status.Error(codes.NotFound, "original post not found")
+			}
+			s.logger.Error("failed to get original post", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to repost")
+		}
+	}
+	if original.AuthorUserID == req.GetUserId() {
+		return nil, status.Error(codes.FailedPrecondition, "cannot repost own post")
+	}
+
+	post := NewPost{AuthorID: req.GetUserId(), RepostOfPostID: original.ID}
+	if strings.TrimSpace(req.GetText()) != "" {
+		text, entities, err := validateContent(req.GetText(), req.GetEntities(), "")
+		if err != nil {
+			return nil, err
+		}
+		filtered := s.opts.ContentFilter.Check(text)
+		if hit, rejected := filtered.Rejected(); rejected {
+			return nil, status.Errorf(codes.InvalidArgument, "content rejected: %s", hit.Detail)
+		}
+		for _, hit := range filtered.Flags() {
+			post.Flags = append(post.Flags, Flag{Reason: "filter:" + hit.Rule, Details: hit.Detail})
+		}
+		post.Text, post.Entities, post.Tags = text, entities, hashtag.Extract(text)
+	}
+
+	postID, err := s.repo.CreatePost(ctx, post)
+	if err != nil {
+		if err == ErrAlreadyReposted {
+			return nil, status.Error(codes.AlreadyExists, "post already reposted")
+		}
+		s.logger.Error("failed to create repost", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to repost")
+	}
+
+	s.publishPostCreated(ctx, postID, req.GetUserId())
+	s.publish(ctx, &eventsv1.PostEvent{
+		EventId:      fmt.Sprintf("repost-%d-%d", postID, time.Now().UnixNano()),
+		EventType:    eventsv1.EventType_EVENT_TYPE_POST_REPOSTED,
+		PostId:       original.ID,
+		ActorUserId:  req.GetUserId(),
+		PostAuthorId: original.AuthorUserID,
+		RepostId:     postID,
+		CreatedAt:    time.Now().UTC().Format(time.RFC3339Nano),
+	})
+
+	return &postv1.RepostPostResponse{PostId: postID}, nil
 }
 
 func (s *Service) GetPost(ctx context.Context, req *postv1.GetPostRequest) (*postv1.GetPostResponse, error) {
@@ -79,6 +262,34 @@ func (s *Service) GetPost(ctx context.Context, req *postv1.GetPostRequest) (*pos
 		return nil, status.Error(codes.Internal, "failed to get post")
 	}
 
+	return toPostResponse(post), nil
+}
+
+func (s *Service) BatchGetPosts(ctx context.Context, req *postv1.BatchGetPostsRequest) (*postv1.BatchGetPostsResponse, error) {
+	postIDs, err := uniquePostIDs(req.GetPostIds())
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := s.repo.BatchGetPosts(ctx, postIDs)
+	if err != nil {
+		s.logger.Error("failed to batch get posts", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get posts")
+	}
+
+	resp := &postv1.BatchGetPostsResponse{Posts: make(map[int64]*postv1.GetPostResponse, len(posts))}
+	for _, id := range postIDs {
+		post, ok := posts[id]
+		if !ok {
+			resp.MissingPostIds = append(resp.MissingPostIds, id)
+			continue
+		}
+		resp.Posts[id] = toPostResponse(post)
+	}
+	return resp, nil
+}
+
+func toPostResponse(post Post) *postv1.GetPostResponse {
 	return &postv1.GetPostResponse{
 		PostId:           post.ID,
 		AuthorUserId:     post.AuthorUserID,
@@ -86,9 +297,363 @@ func (s *Service) GetPost(ctx context.Context, req *postv1.GetPostRequest) (*pos
 		MediaType:        post.MediaType,
 		TelegramFileId:   post.TelegramFileID,
 		TelegramUniqueId: post.TelegramUniqueID,
+		Tags:             post.Tags,
+		Entities:         toProtoEntities(post.Entities),
+		RepostOfPostId:   post.RepostOfPostID,
+	}
+}
+
+// uniquePostIDs проверяет размер пачки и убирает повторы, сохраняя порядок.
+func uniquePostIDs(postIDs []int64) ([]int64, error) {
+	if len(postIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "post_ids is required")
+	}
+	if len(postIDs) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many post_ids: got %d, max %d", len(postIDs), maxBatchSize)
+	}
+	for _, id := range postIDs {
+		if id <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid post_id %d", id)
+		}
+	}
+	return ids.Unique(postIDs), nil
+}
+
+func (s *Service) EditPost(ctx context.Context, req *postv1.EditPostRequest) (*postv1.EditPostResponse, error) {
+	if req.GetAuthorUserId() == 0 || req.GetPostId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "author_user_id and post_id are required")
+	}
+	if _, err := authz.CheckBan(ctx, s.users, req.GetAuthorUserId(), userv1.BanScope_BAN_SCOPE_POSTING); err != nil {
+		return nil, err
+	}
+	current, err := s.repo.GetPost(ctx, req.GetPostId())
+	if err != nil {
+		if err == ErrPostNotFound {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+		s.logger.Error("failed to get post", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to edit post")
+	}
+
+	text, entities, err := validateContent(req.GetText(), req.GetEntities(), current.MediaType)
+	if err != nil {
+		return nil, err
+	}
+	// правка не должна обходить фильтр: отметки сохраняются так же, как при создании
+	filtered := s.opts.ContentFilter.Check(text)
+	if hit, rejected := filtered.Rejected(); rejected {
+		return nil, status.Errorf(codes.InvalidArgument, "content rejected: %s", hit.Detail)
+	}
+	var flags []Flag
+	for _, hit := range filtered.Flags() {
+		flags = append(flags, Flag{Reason: "filter:" + hit.Rule, Details: hit.Detail})
+	}
+
+	var simhash *int64
+	if h, ok := simHash(text); ok {
+		simhash = &h
+	}
+	if err := s.repo.EditPost(ctx, req.GetAuthorUserId(), req.GetPostId(), text, entities, hashtag.Extract(text), contentHash(text), simhash, flags); err != nil {
+		switch err {
+		case ErrPostNotFound:
+			return nil, status.Error(codes.NotFound, "post not found")
+		case ErrNotPostAuthor:
+			return nil, status.Error(codes.PermissionDenied, "only the author can edit the post")
+		}
+		s.logger.Error("failed to edit post", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to edit post")
+	}
+
+	return &postv1.EditPostResponse{}, nil
+}
+
+func (s *Service) ListPostsByTag(ctx context.Context, req *postv1.ListPostsByTagRequest) (*postv1.ListPostsByTagResponse, error) {
+	tag := hashtag.Normalize(req.GetTag())
+	if tag == "" {
+		return nil, status.Error(codes.InvalidArgument, "tag is required")
+	}
+	after, err := cursor.Decode(req.GetCursor())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+	limit := clampLimit(req.GetLimit())
+
+	items, hasMore, err := s.repo.ListPostsByTag(ctx, tag, after, limit)
+	if err != nil {
+		s.logger.Error("failed to list posts by tag", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list posts")
+	}
+
+	resp := &postv1.ListPostsByTagResponse{
+		Posts:   make([]*postv1.PostSummary, 0, len(items)),
+		HasMore: hasMore,
+	}
+	for _, item := range items {
+		resp.Posts = append(resp.Posts, &postv1.PostSummary{
+			PostId:      item.PostID,
+			TextPreview: item.TextPreview,
+			MediaType:   item.MediaType,
+			CreatedAt:   item.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	if hasMore && len(items) > 0 {
+		last := items[len(items)-1]
+		resp.NextCursor = cursor.Encode(cursor.Cursor{CreatedAt: last.CreatedAt, ID: last.PostID})
+	}
+
+	return resp, nil
+}
+
+func (s *Service) ListTrendingTags(ctx context.Context, req *postv1.ListTrendingTagsRequest) (*postv1.ListTrendingTagsResponse, error) {
+	window := defaultTrendingWindow
+	if req.GetWindowHours() > 0 {
+		window = time.Duration(req.GetWindowHours()) * time.Hour
+	}
+	if window > maxTrendingWindow {
+		return nil, status.Error(codes.InvalidArgument, "window_hours must not exceed 720")
+	}
+
+	tags, err := s.repo.ListTrendingTags(ctx, window, clampLimit(req.GetLimit()))
+	if err != nil {
+		s.logger.Error("failed to list trending tags", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list trending tags")
+	}
+
+	resp := &postv1.ListTrendingTagsResponse{Tags: make([]*postv1.TagCount, 0, len(tags))}
+	for _, t := range tags {
+		resp.Tags = append(resp.Tags, &postv1.TagCount{Tag: t.Tag, Posts: t.Posts})
+	}
+	return resp, nil
+}
+
+func (s *Service) SearchPosts(ctx context.Context, req *postv1.SearchPostsRequest) (*postv1.SearchPostsResponse, error) {
+	query := strings.TrimSpace(req.GetQuery())
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	if len([]rune(query)) > maxSearchQueryLength {
+		return nil, status.Error(codes.InvalidArgument, "query is too long")
+	}
+	offset, err := cursor.DecodeOffset(req.GetCursor())
+	if err != nil || offset > maxSearchOffset {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+	limit := clampLimit(req.GetLimit())
+
+	hits, hasMore, err := s.repo.SearchPosts(ctx, query, offset, limit)
+	if err != nil {
+		s.logger.Error("failed to search posts", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to search posts")
+	}
+
+	resp := &postv1.SearchPostsResponse{
+		Results: make([]*postv1.SearchResult, 0, len(hits)),
+		HasMore: hasMore && offset+limit <= maxSearchOffset,
+	}
+	for _, hit := range hits {
+		resp.Results = append(resp.Results, &postv1.SearchResult{
+			PostId:    hit.PostID,
+			Snippet:   hit.Snippet,
+			Rank:      hit.Rank,
+			MediaType: hit.MediaType,
+			CreatedAt: hit.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	if resp.HasMore {
+		resp.NextCursor = cursor.EncodeOffset(offset + limit)
+	}
+
+	return resp, nil
+}
+
+func (s *Service) SaveDraft(ctx context.Context, req *postv1.SaveDraftRequest) (*postv1.SaveDraftResponse, error) {
+	d := req.GetDraft()
+	if req.GetUserId() == 0 || d == nil {
+		return nil, status.Error(codes.InvalidArgument, "user_id and draft are required")
+	}
+
+	// черновик может быть неполным, поэтому проверяем только то, что нельзя хранить
+	mediaType := strings.TrimSpace(d.GetMediaType())
+	if mediaType != "" && mediaType != "photo" && mediaType != "video" && mediaType != "poll" {
+		return nil, status.Error(codes.InvalidArgument, "media_type must be empty, photo, video or poll")
+	}
+	if utf16Len(d.GetText()) > maxTextLength {
+		return nil, status.Errorf(codes.InvalidArgument, "text is too long, max %d characters", maxTextLength)
+	}
+	if len(d.GetEntities()) > maxEntities || len(d.GetPollOptions()) > maxPollOptions {
+		return nil, status.Error(codes.InvalidArgument, "too many entities or poll options")
+	}
+
+	draft := Draft{
+		ID:                 d.GetDraftId(),
+		UserID:             req.GetUserId(),
+		Text:               d.GetText(),
+		Entities:           entitiesFromProto(d.GetEntities()),
+		MediaType:          mediaType,
+		TelegramFileID:     d.GetTelegramFileId(),
+		TelegramUniqueID:   d.GetTelegramUniqueId(),
+		PollOptions:        d.GetPollOptions(),
+		PollMultipleChoice: d.GetPollMultipleChoice(),
+	}
+	if d.GetScheduledAt() != "" {
+		at, err := time.Parse(time.RFC3339, d.GetScheduledAt())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "scheduled_at must be RFC3339")
+		}
+		if now := time.Now(); !at.After(now) || at.Sub(now) > maxScheduleAhead {
+			return nil, status.Error(codes.InvalidArgument, "scheduled_at must be within the next 30 days")
+		}
+		draft.ScheduledAt = &at
+	}
+
+	id, err := s.repo.SaveDraft(ctx, draft, maxDraftsPerUser)
+	if err != nil {
+		switch err {
+		case ErrDraftNotFound:
+			return nil, status.Error(codes.NotFound, "draft not found")
+		case ErrTooManyDrafts:
+			return nil, status.Errorf(codes.ResourceExhausted, "drafts limit reached, max %d", maxDraftsPerUser)
+		}
+		s.logger.Error("failed to save draft", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to save draft")
+	}
+
+	return &postv1.SaveDraftResponse{DraftId: id}, nil
+}
+
+func (s *Service) ListDrafts(ctx context.Context, req *postv1.ListDraftsRequest) (*postv1.ListDraftsResponse, error) {
+	if req.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	drafts, err := s.repo.ListDrafts(ctx, req.GetUserId())
+	if err != nil {
+		s.logger.Error("failed to list drafts", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list drafts")
+	}
+
+	resp := &postv1.ListDraftsResponse{Drafts: make([]*postv1.Draft, 0, len(drafts))}
+	for _, d := range drafts {
+		item := &postv1.Draft{
+			DraftId:            d.ID,
+			Text:               d.Text,
+			Entities:           toProtoEntities(d.Entities),
+			MediaType:          d.MediaType,
+			TelegramFileId:     d.TelegramFileID,
+			TelegramUniqueId:   d.TelegramUniqueID,
+			PollOptions:        d.PollOptions,
+			PollMultipleChoice: d.PollMultipleChoice,
+			UpdatedAt:          d.UpdatedAt.UTC().Format(time.RFC3339),
+		}
+		if d.ScheduledAt != nil {
+			item.ScheduledAt = d.ScheduledAt.UTC().Format(time.RFC3339)
+		}
+		resp.Drafts = append(resp.Drafts, item)
+	}
+	return resp, nil
+}
+
+func (s *Service) PublishDraft(ctx context.Context, req *postv1.PublishDraftRequest) (*postv1.PublishDraftResponse, error) {
+	if req.GetUserId() == 0 || req.GetDraftId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and draft_id are required")
+	}
+
+	draft, err := s.repo.GetDraft(ctx, req.GetUserId(), req.GetDraftId())
+	if err != nil {
+		if err == ErrDraftNotFound {
+			return nil, status.Error(codes.NotFound, "draft not found")
+		}
+		s.logger.Error("failed to get draft", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to publish draft")
+	}
+
+	created, err := s.publishDraft(ctx, draft)
+	if err != nil {
+		return nil, err
+	}
+	return &postv1.PublishDraftResponse{
+		PostId:            created.GetPostId(),
+		DuplicateOfPostId: created.GetDuplicateOfPostId(),
+		DuplicateKind:     created.GetDuplicateKind(),
 	}, nil
 }
 
+func (s *Service) DeleteDraft(ctx context.Context, req *postv1.DeleteDraftRequest) (*postv1.DeleteDraftResponse, error) {
+	if req.GetUserId() == 0 || req.GetDraftId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and draft_id are required")
+	}
+
+	if err := s.repo.DeleteDraft(ctx, req.GetUserId(), req.GetDraftId()); err != nil {
+		if err == ErrDraftNotFound {
+			return nil, status.Error(codes.NotFound, "draft not found")
+		}
+		s.logger.Error("failed to delete draft", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to delete draft")
+	}
+	return &postv1.DeleteDraftResponse{}, nil
+}
+
+func (s *Service) publishDraft(ctx context.Context, d Draft) (*postv1.CreatePostResponse, error) {
+	return s.createPost(ctx, &postv1.CreatePostRequest{
+		AuthorUserId:       d.UserID,
+		Text:               d.Text,
+		MediaType:          d.MediaType,
+		TelegramFileId:     d.TelegramFileID,
+		TelegramUniqueId:   d.TelegramUniqueID,
+		Entities:           toProtoEntities(d.Entities),
+		PollOptions:        d.PollOptions,
+		PollMultipleChoice: d.PollMultipleChoice,
+	}, d.ID)
+}
+
+// RunDraftScheduler публикует отложенные черновики, пока контекст не завершится.
+func (s *Service) RunDraftScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		drafts, err := s.repo.DueDrafts(ctx, draftSchedulerBatch)
+		if err != nil {
+			s.logger.Error("failed to load scheduled drafts", zap.Error(err))
+			continue
+		}
+
+		for _, d := range drafts {
+			created, err := s.publishDraft(ctx, d)
+			switch status.Code(err) {
+			case codes.OK:
+				s.logger.Info("scheduled draft published", zap.Int64("draft_id", d.ID), zap.Int64("post_id", created.GetPostId()))
+			case codes.InvalidArgument, codes.AlreadyExists, codes.PermissionDenied:
+				// невалидный, повторяющийся или забаненный черновик не опубликуется и при следующей попытке
+				s.logger.Warn("scheduled draft rejected", zap.Int64("draft_id", d.ID), zap.Error(err))
+				if err := s.repo.ClearDraftSchedule(ctx, d.ID); err != nil {
+					s.logger.Error("failed to clear draft schedule", zap.Error(err))
+				}
+			case codes.NotFound:
+				// уже опубликован другой репликой или удалён
+			default:
+				s.logger.Error("failed to publish scheduled draft", zap.Int64("draft_id", d.ID), zap.Error(err))
+			}
+		}
+	}
+}
+
+func clampLimit(limit int32) int32 {
+	if limit <= 0 {
+		return 10
+	}
+	if limit > maxPageLimit {
+		return maxPageLimit
+	}
+	return limit
+}
+
 func (s *Service) ListUserPosts(ctx context.Context, req *postv1.ListUserPostsRequest) (*postv1.ListUserPostsResponse, error) {
 	if req.GetUserId() == 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
@@ -97,7 +662,12 @@ func (s *Service) ListUserPosts(ctx context.Context, req *postv1.ListUserPostsRe
 		req.PageSize = 10
 	}
 
-	items, hasMore, err := s.repo.ListUserPosts(ctx, req.GetUserId(), req.GetPage(), req.GetPageSize())
+	after, err := cursor.Decode(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	items, hasMore, err := s.repo.ListUserPosts(ctx, req.GetUserId(), req.GetPage(), req.GetPageSize(), after)
 	if err != nil {
 		s.logger.Error("failed to list posts", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to list posts")
@@ -118,8 +688,13 @@ func (s *Service) ListUserPosts(ctx context.Context, req *postv1.ListUserPostsRe
 			Dislikes:    item.Dislikes,
 			Comments:    item.Comments,
 			Views:       item.Views,
+			Saves:       item.Saves,
 		})
 	}
+	if hasMore && len(items) > 0 {
+		last := items[len(items)-1]
+		resp.NextPageToken = cursor.Encode(cursor.Cursor{CreatedAt: last.CreatedAt, ID: last.PostID})
+	}
 
 	return resp, nil
 }
@@ -137,15 +712,22 @@ func (s *Service) publishPostCreated(ctx context.Context, postID int64, authorID
 		PostAuthorId: authorID,
 		CreatedAt:    time.Now().UTC().Format(time.RFC3339Nano),
 	}
+	s.publish(ctx, event)
+}
+
+func (s *Service) publish(ctx context.Context, event *eventsv1.PostEvent) {
+	if s.producer == nil {
+		return
+	}
 
 	payload, err := proto.Marshal(event)
 	if err != nil {
-		s.logger.Error("failed to marshal post created event", zap.Error(err))
+		s.logger.Error("failed to marshal post event", zap.Error(err), zap.Stringer("type", event.GetEventType()))
 		return
 	}
 
-	key := []byte(strconv.FormatInt(postID, 10))
+	key := []byte(strconv.FormatInt(event.GetPostId(), 10))
 	if err := s.producer.Send(ctx, topicPostEvents, key, payload); err != nil {
-		s.logger.Error("failed to publish post created event", zap.Error(err))
+		s.logger.Error("failed to publish post event", zap.Error(err), zap.Stringer("type", event.GetEventType()))
 	}
 }
@@ -0,0 +1,212 @@
+package post
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	postv1 "ghostnet/gen/go/proto/post/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxTextLength — лимит Telegram на текст сообщения (в UTF-16).
+	maxTextLength = 4096
+	// maxCaptionLength — лимит Telegram на подпись к фото и видео (в UTF-16).
+	maxCaptionLength = 1024
+	maxEntities      = 100
+
+	minPollOptions      = 2
+	maxPollOptions      = 10
+	maxPollOptionLength = 100
+)
+
+// Entity — элемент форматирования Telegram, хранится вместе с постом.
+type Entity struct {
+	Type          string `json:"type"`
+	Offset        int32  `json:"offset"`
+	Length        int32  `json:"length"`
+	URL           string `json:"url,omitempty"`
+	Language      string `json:"language,omitempty"`
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+}
+
+// Сохраняем только оформление, заданное автором. Ссылки, хэштеги и упоминания Telegram
+// размечает сам при доставке, а text_mention раскрыл бы чужой аккаунт.
+var storedEntityTypes = map[string]bool{
+	"bold":                  true,
+	"italic":                true,
+	"underline":             true,
+	"strikethrough":         true,
+	"spoiler":               true,
+	"code":                  true,
+	"pre":                   true,
+	"text_link":             true,
+	"blockquote":            true,
+	"expandable_blockquote": true,
+	"custom_emoji":          true,
+}
+
+// Символы-заполнители, которые не относятся к Cf, но отображаются пустотой.
+var blankRunes = map[rune]bool{
+	'\u115F': true, // HANGUL CHOSEONG FILLER
+	'\u1160': true, // HANGUL JUNGSEONG FILLER
+	'\u2800': true, // BRAILLE PATTERN BLANK
+	'\u3164': true, // HANGUL FILLER
+	'\uFFA0': true, // HALFWIDTH HANGUL FILLER
+}
+
+// validateContent обрезает пробелы по краям текста, проверяет лимиты для типа медиа
+// и сдвигает форматирование под обрезанный текст.
+func validateContent(text string, entities []*postv1.MessageEntity, mediaType string) (string, []Entity, error) {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return "", nil, status.Error(codes.InvalidArgument, "text is required")
+	}
+	if !hasVisibleChars(trimmed) {
+		return "", nil, status.Error(codes.InvalidArgument, "text must contain visible characters")
+	}
+
+	limit := maxTextLength
+	if mediaType == "photo" || mediaType == "video" {
+		limit = maxCaptionLength
+	}
+	length := utf16Len(trimmed)
+	if length > limit {
+		return "", nil, status.Errorf(codes.InvalidArgument, "text is too long: %d characters, max %d", length, limit)
+	}
+
+	if len(entities) > maxEntities {
+		return "", nil, status.Errorf(codes.InvalidArgument, "too many entities, max %d", maxEntities)
+	}
+
+	fullLength := int32(utf16Len(text))
+	shift := int32(utf16Len(text[:len(text)-len(strings.TrimLeftFunc(text, unicode.IsSpace))]))
+
+	out := make([]Entity, 0, len(entities))
+	for _, e := range entities {
+		if e.GetOffset() < 0 || e.GetLength() <= 0 || e.GetOffset()+e.GetLength() > fullLength {
+			return "", nil, status.Error(codes.InvalidArgument, "entity is out of text bounds")
+		}
+		if !storedEntityTypes[e.GetType()] {
+			continue
+		}
+		if e.GetType() == "text_link" && !isAllowedLink(e.GetUrl()) {
+			return "", nil, status.Error(codes.InvalidArgument, "text_link requires an http, https or tg url")
+		}
+		if e.GetType() == "custom_emoji" && e.GetCustomEmojiId() == "" {
+			return "", nil, status.Error(codes.InvalidArgument, "custom_emoji requires custom_emoji_id")
+		}
+
+		start := max(e.GetOffset()-shift, 0)
+		end := min(e.GetOffset()+e.GetLength()-shift, int32(length))
+		if end <= start {
+			continue
+		}
+
+		out = append(out, Entity{
+			Type:          e.GetType(),
+			Offset:        start,
+			Length:        end - start,
+			URL:           e.GetUrl(),
+			Language:      e.GetLanguage(),
+			CustomEmojiID: e.GetCustomEmojiId(),
+		})
+	}
+
+	return trimmed, out, nil
+}
+
+// validatePollOptions проверяет варианты ответа: 2–10 непустых уникальных строк.
+func validatePollOptions(options []string) ([]string, error) {
+	if len(options) < minPollOptions || len(options) > maxPollOptions {
+		return nil, status.Errorf(codes.InvalidArgument, "poll must have from %d to %d options", minPollOptions, maxPollOptions)
+	}
+
+	out := make([]string, 0, len(options))
+	seen := make(map[string]struct{}, len(options))
+	for _, opt := range options {
+		opt = strings.TrimSpace(opt)
+		if opt == "" || !hasVisibleChars(opt) {
+			return nil, status.Error(codes.InvalidArgument, "poll option must not be empty")
+		}
+		if utf16Len(opt) > maxPollOptionLength {
+			return nil, status.Errorf(codes.InvalidArgument, "poll option is too long, max %d characters", maxPollOptionLength)
+		}
+		key := strings.ToLower(opt)
+		if _, ok := seen[key]; ok {
+			return nil, status.Error(codes.InvalidArgument, "poll options must be unique")
+		}
+		seen[key] = struct{}{}
+		out = append(out, opt)
+	}
+	return out, nil
+}
+
+func hasVisibleChars(text string) bool {
+	for _, r := range text {
+		if unicode.IsSpace(r) || unicode.IsControl(r) || unicode.Is(unicode.Cf, r) || blankRunes[r] {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func isAllowedLink(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	switch u.Scheme {
+	case "http", "https":
+		return u.Host != ""
+	case "tg":
+		return true
+	}
+	return false
+}
+
+// utf16Len считает длину так же, как Telegram: в единицах UTF-16.
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += utf16.RuneLen(r)
+	}
+	return n
+}
+
+func toProtoEntities(entities []Entity) []*postv1.MessageEntity {
+	out := make([]*postv1.MessageEntity, 0, len(entities))
+	for _, e := range entities {
+		out = append(out, &postv1.MessageEntity{
+			Type:          e.Type,
+			Offset:        e.Offset,
+			Length:        e.Length,
+			Url:           e.URL,
+			Language:      e.Language,
+			CustomEmojiId: e.CustomEmojiID,
+		})
+	}
+	return out
+}
+
+// entitiesFromProto переносит форматирование без проверок — для черновиков,
+// которые проверяются целиком при публикации.
+func entitiesFromProto(entities []*postv1.MessageEntity) []Entity {
+	out := make([]Entity, 0, len(entities))
+	for _, e := range entities {
+		out = append(out, Entity{
+			Type:          e.GetType(),
+			Offset:        e.GetOffset(),
+			Length:        e.GetLength(),
+			URL:           e.GetUrl(),
+			Language:      e.GetLanguage(),
+			CustomEmojiID: e.GetCustomEmojiId(),
+		})
+	}
+	return out
+}
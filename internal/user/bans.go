@@ -0,0 +1,105 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	userv1 "ghostnet/gen/go/proto/user/v1"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrBanNotFound возвращается, когда активного бана с таким id нет.
+var ErrBanNotFound = errors.New("ban not found")
+
+// Области бана хранятся в user_bans.scope.
+const (
+	BanScopePosting    = "posting"
+	BanScopeCommenting = "commenting"
+	BanScopeAll        = "all"
+)
+
+// Ban — запись о бане. ExpiresAt равен nil для бессрочного бана.
+type Ban struct {
+	ID        int64
+	UserID    int64
+	Scope     string
+	Reason    string
+	Shadow    bool
+	ExpiresAt *time.Time
+	CreatedBy int64
+}
+
+func fromProtoBanScope(scope userv1.BanScope) (string, bool) {
+	switch scope {
+	case userv1.BanScope_BAN_SCOPE_POSTING:
+		return BanScopePosting, true
+	case userv1.BanScope_BAN_SCOPE_COMMENTING:
+		return BanScopeCommenting, true
+	case userv1.BanScope_BAN_SCOPE_ALL:
+		return BanScopeAll, true
+	default:
+		return "", false
+	}
+}
+
+// CreateBan сохраняет бан и возвращает его id.
+func (r *Repository) CreateBan(ctx context.Context, b Ban) (int64, error) {
+	exists, err := r.userExists(ctx, b.UserID)
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, ErrUserNotFound
+	}
+
+	var id int64
+	err = r.pool.QueryRow(ctx, `
+		INSERT INTO user_bans (user_id, scope, reason, shadow, expires_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, 0))
+		RETURNING id
+	`, b.UserID, b.Scope, b.Reason, b.Shadow, b.ExpiresAt, b.CreatedBy).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("insert ban: %w", err)
+	}
+	return id, nil
+}
+
+// RevokeBan снимает ещё действующий бан.
+func (r *Repository) RevokeBan(ctx context.Context, banID int64) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE user_bans SET revoked_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())
+	`, banID)
+	if err != nil {
+		return fmt.Errorf("revoke ban: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrBanNotFound
+	}
+	return nil
+}
+
+// ActiveBan ищет действующий бан, запрещающий действие из scope: бан с той же областью или на всё.
+// Обычный бан важнее теневого, бессрочный — важнее временного.
+func (r *Repository) ActiveBan(ctx context.Context, userID int64, scope string) (Ban, bool, error) {
+	b := Ban{UserID: userID}
+	err := r.pool.QueryRow(ctx, `
+		SELECT id, scope, reason, shadow, expires_at
+		FROM user_bans
+		WHERE user_id = $1 AND revoked_at IS NULL
+		  AND (expires_at IS NULL OR expires_at > NOW())
+		  AND scope IN ($2, 'all')
+		ORDER BY shadow, expires_at DESC NULLS FIRST
+		LIMIT 1
+	`, userID, scope).Scan(&b.ID, &b.Scope, &b.Reason, &b.Shadow, &b.ExpiresAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return b, false, nil
+	}
+	if err != nil {
+		return b, false, fmt.Errorf("select active ban: %w", err)
+	}
+	return b, true, nil
+}
@@ -24,11 +24,67 @@ CREATE TABLE IF NOT EXISTS user_notification_settings (
     updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );`
 
+const addNotifyOnReactionColumn = `
+ALTER TABLE user_notification_settings ADD COLUMN IF NOT EXISTS notify_on_reaction BOOLEAN NOT NULL DEFAULT TRUE;`
+
+const addRoleColumn = `
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(16) NOT NULL DEFAULT 'user'
+    CHECK (role IN ('user', 'moderator', 'admin'));`
+
+// is_moderator заменён ролью: переносим флаг и удаляем колонку.
+const migrateModeratorFlag = `
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_name = 'users' AND column_name = 'is_moderator') THEN
+        UPDATE users SET role = 'moderator' WHERE is_moderator AND role = 'user';
+        ALTER TABLE users DROP COLUMN is_moderator;
+    END IF;
+END $$;`
+
+const createRoleChangesTable = `
+CREATE TABLE IF NOT EXISTS user_role_changes (
+    id            BIGSERIAL PRIMARY KEY,
+    user_id       BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    previous_role VARCHAR(16) NOT NULL,
+    role          VARCHAR(16) NOT NULL,
+    changed_by    BIGINT,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);`
+
+// user_bans ведёт только user-service: модерация банит авторов через BanUser.
+const createUserBansTable = `
+CREATE TABLE IF NOT EXISTS user_bans (
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason     TEXT NOT NULL DEFAULT '',
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);`
+
+const addBanScopeColumns = `
+ALTER TABLE user_bans
+    ADD COLUMN IF NOT EXISTS scope VARCHAR(16) NOT NULL DEFAULT 'all'
+        CHECK (scope IN ('posting', 'commenting', 'all')),
+    ADD COLUMN IF NOT EXISTS shadow BOOLEAN NOT NULL DEFAULT FALSE;`
+
+const createActiveBansIndex = `
+CREATE INDEX IF NOT EXISTS idx_user_bans_active ON user_bans (user_id) WHERE revoked_at IS NULL;`
+
 // RunMigrations выполняет минимальный набор миграций для user-service.
 func RunMigrations(ctx context.Context, pool *pgxpool.Pool) error {
 	stmts := []string{
 		createUsersTable,
 		createSettingsTable,
+		addNotifyOnReactionColumn,
+		addRoleColumn,
+		migrateModeratorFlag,
+		createRoleChangesTable,
+		createUserBansTable,
+		addBanScopeColumns,
+		createActiveBansIndex,
 	}
 
 	for _, stmt := range stmts {
@@ -16,9 +16,10 @@ var (
 
 // NotificationSettings хранит пользовательские настройки уведомлений.
 type NotificationSettings struct {
-	NotifyOnLike    bool
-	NotifyOnDislike bool
-	NotifyOnComment bool
+	NotifyOnLike     bool
+	NotifyOnDislike  bool
+	NotifyOnComment  bool
+	NotifyOnReaction bool
 }
 
 // Repository инкапсулирует доступ к данным user-service.
@@ -42,31 +43,97 @@ func (r *Repository) GetUser(ctx context.Context, userID int64) (int64, error) {
 	return telegramID, nil
 }
 
-func (r *Repository) GetOrCreateUser(ctx context.Context, telegramID int64) (int64, error) {
+// GetOrCreateUser возвращает id пользователя и его роль.
+func (r *Repository) GetOrCreateUser(ctx context.Context, telegramID int64) (int64, string, error) {
 	var id int64
+	var role string
 	err := r.pool.QueryRow(ctx, `
 		INSERT INTO users (telegram_id) VALUES ($1)
 		ON CONFLICT (telegram_id) DO UPDATE SET telegram_id = EXCLUDED.telegram_id
-		RETURNING id
-	`, telegramID).Scan(&id)
+		RETURNING id, role
+	`, telegramID).Scan(&id, &role)
 	if err != nil {
-		return 0, fmt.Errorf("insert user: %w", err)
+		return 0, "", fmt.Errorf("insert user: %w", err)
 	}
 
 	if err := r.ensureSettingsRow(ctx, id); err != nil {
-		return 0, err
+		return 0, "", err
 	}
 
-	return id, nil
+	return id, role, nil
+}
+
+// GetRole возвращает роль пользователя.
+func (r *Repository) GetRole(ctx context.Context, userID int64) (string, error) {
+	var role string
+	err := r.pool.QueryRow(ctx, `SELECT role FROM users WHERE id = $1`, userID).Scan(&role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrUserNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("get role: %w", err)
+	}
+	return role, nil
+}
+
+// SetRole меняет роль пользователя и записывает смену в user_role_changes.
+// changedBy равен нулю для изменений при старте сервиса. Возвращает прежнюю роль.
+func (r *Repository) SetRole(ctx context.Context, userID int64, role string, changedBy int64) (string, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var previous string
+	err = tx.QueryRow(ctx, `SELECT role FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&previous)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrUserNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("lock user: %w", err)
+	}
+	if previous == role {
+		return previous, nil
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE users SET role = $2 WHERE id = $1`, userID, role); err != nil {
+		return "", fmt.Errorf("update role: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO user_role_changes (user_id, previous_role, role, changed_by)
+		VALUES ($1, $2, $3, NULLIF($4, 0))
+	`, userID, previous, role, changedBy); err != nil {
+		return "", fmt.Errorf("insert role change: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("commit: %w", err)
+	}
+	return previous, nil
+}
+
+// EnsureAdmins выдаёт роль администратора пользователям из списка telegram id, создавая их при необходимости.
+func (r *Repository) EnsureAdmins(ctx context.Context, telegramIDs []int64) error {
+	for _, telegramID := range telegramIDs {
+		id, _, err := r.GetOrCreateUser(ctx, telegramID)
+		if err != nil {
+			return err
+		}
+		if _, err := r.SetRole(ctx, id, RoleAdmin, 0); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (r *Repository) GetNotificationSettings(ctx context.Context, userID int64) (NotificationSettings, error) {
 	var s NotificationSettings
 	err := r.pool.QueryRow(ctx, `
-		SELECT notify_on_like, notify_on_dislike, notify_on_comment
+		SELECT notify_on_like, notify_on_dislike, notify_on_comment, notify_on_reaction
 		FROM user_notification_settings
 		WHERE user_id = $1
-	`, userID).Scan(&s.NotifyOnLike, &s.NotifyOnDislike, &s.NotifyOnComment)
+	`, userID).Scan(&s.NotifyOnLike, &s.NotifyOnDislike, &s.NotifyOnComment, &s.NotifyOnReaction)
 	if err == nil {
 		return s, nil
 	}
@@ -98,14 +165,15 @@ func (r *Repository) UpsertNotificationSettings(ctx context.Context, userID int6
 	}
 
 	_, err = r.pool.Exec(ctx, `
-		INSERT INTO user_notification_settings (user_id, notify_on_like, notify_on_dislike, notify_on_comment, updated_at)
-		VALUES ($1, $2, $3, $4, NOW())
+		INSERT INTO user_notification_settings (user_id, notify_on_like, notify_on_dislike, notify_on_comment, notify_on_reaction, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
 		ON CONFLICT (user_id) DO UPDATE
 		SET notify_on_like = EXCLUDED.notify_on_like,
 		    notify_on_dislike = EXCLUDED.notify_on_dislike,
 		    notify_on_comment = EXCLUDED.notify_on_comment,
+		    notify_on_reaction = EXCLUDED.notify_on_reaction,
 		    updated_at = NOW()
-	`, userID, s.NotifyOnLike, s.NotifyOnDislike, s.NotifyOnComment)
+	`, userID, s.NotifyOnLike, s.NotifyOnDislike, s.NotifyOnComment, s.NotifyOnReaction)
 	if err != nil {
 		return fmt.Errorf("upsert settings: %w", err)
 	}
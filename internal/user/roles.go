@@ -0,0 +1,56 @@
+package user
+
+import userv1 "ghostnet/gen/go/proto/user/v1"
+
+// Роли хранятся в users.role.
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+// rolePermissions — что разрешено каждой роли. Обычному пользователю привилегий не положено.
+var rolePermissions = map[string][]userv1.Permission{
+	RoleModerator: {
+		userv1.Permission_PERMISSION_MODERATE_CONTENT,
+		userv1.Permission_PERMISSION_BAN_USERS,
+	},
+	RoleAdmin: {
+		userv1.Permission_PERMISSION_MODERATE_CONTENT,
+		userv1.Permission_PERMISSION_BAN_USERS,
+		userv1.Permission_PERMISSION_MANAGE_ROLES,
+	},
+}
+
+func hasPermission(role string, perm userv1.Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+func toProtoRole(role string) userv1.Role {
+	switch role {
+	case RoleModerator:
+		return userv1.Role_ROLE_MODERATOR
+	case RoleAdmin:
+		return userv1.Role_ROLE_ADMIN
+	default:
+		return userv1.Role_ROLE_USER
+	}
+}
+
+func fromProtoRole(role userv1.Role) (string, bool) {
+	switch role {
+	case userv1.Role_ROLE_USER:
+		return RoleUser, true
+	case userv1.Role_ROLE_MODERATOR:
+		return RoleModerator, true
+	case userv1.Role_ROLE_ADMIN:
+		return RoleAdmin, true
+	default:
+		return "", false
+	}
+}
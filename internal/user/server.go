@@ -2,6 +2,9 @@ package user
 
 import (
 	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	userv1 "ghostnet/gen/go/proto/user/v1"
 	"go.uber.org/zap"
@@ -9,6 +12,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const maxBanReasonLength = 500
+
 // Service реализует gRPC контракты user-service.
 type Service struct {
 	userv1.UnimplementedUserServiceServer
@@ -28,13 +33,17 @@ func (s *Service) GetOrCreateUser(ctx context.Context, req *userv1.GetOrCreateUs
 		return nil, status.Error(codes.InvalidArgument, "telegram_id is required")
 	}
 
-	userID, err := s.repo.GetOrCreateUser(ctx, req.GetTelegramId())
+	userID, role, err := s.repo.GetOrCreateUser(ctx, req.GetTelegramId())
 	if err != nil {
 		s.logger.Error("failed to get or create user", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to store user")
 	}
 
-	return &userv1.GetOrCreateUserResponse{UserId: userID}, nil
+	return &userv1.GetOrCreateUserResponse{
+		UserId:      userID,
+		IsModerator: hasPermission(role, userv1.Permission_PERMISSION_MODERATE_CONTENT),
+		Role:        toProtoRole(role),
+	}, nil
 }
 
 func (s *Service) GetUser(ctx context.Context, req *userv1.GetUserRequest) (*userv1.GetUserResponse, error) {
@@ -72,9 +81,10 @@ func (s *Service) GetNotificationSettings(ctx context.Context, req *userv1.GetNo
 	}
 
 	return &userv1.GetNotificationSettingsResponse{
-		NotifyOnLike:    settings.NotifyOnLike,
-		NotifyOnDislike: settings.NotifyOnDislike,
-		NotifyOnComment: settings.NotifyOnComment,
+		NotifyOnLike:     settings.NotifyOnLike,
+		NotifyOnDislike:  settings.NotifyOnDislike,
+		NotifyOnComment:  settings.NotifyOnComment,
+		NotifyOnReaction: settings.NotifyOnReaction,
 	}, nil
 }
 
@@ -84,9 +94,10 @@ func (s *Service) UpdateNotificationSettings(ctx context.Context, req *userv1.Up
 	}
 
 	settings := NotificationSettings{
-		NotifyOnLike:    req.GetNotifyOnLike(),
-		NotifyOnDislike: req.GetNotifyOnDislike(),
-		NotifyOnComment: req.GetNotifyOnComment(),
+		NotifyOnLike:     req.GetNotifyOnLike(),
+		NotifyOnDislike:  req.GetNotifyOnDislike(),
+		NotifyOnComment:  req.GetNotifyOnComment(),
+		NotifyOnReaction: req.GetNotifyOnReaction(),
 	}
 
 	if err := s.repo.UpsertNotificationSettings(ctx, req.GetUserId(), settings); err != nil {
@@ -99,3 +110,193 @@ func (s *Service) UpdateNotificationSettings(ctx context.Context, req *userv1.Up
 
 	return &userv1.UpdateNotificationSettingsResponse{}, nil
 }
+
+// CheckPermission отвечает, разрешено ли пользователю действие. Неизвестный пользователь прав не имеет.
+func (s *Service) CheckPermission(ctx context.Context, req *userv1.CheckPermissionRequest) (*userv1.CheckPermissionResponse, error) {
+	if req.GetUserId() == 0 || req.GetPermission() == userv1.Permission_PERMISSION_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "user_id and permission are required")
+	}
+
+	role, err := s.repo.GetRole(ctx, req.GetUserId())
+	if err != nil {
+		if err == ErrUserNotFound {
+			return &userv1.CheckPermissionResponse{Allowed: false, Role: userv1.Role_ROLE_UNSPECIFIED}, nil
+		}
+		s.logger.Error("failed to get role", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to check permission")
+	}
+
+	return &userv1.CheckPermissionResponse{
+		Allowed: hasPermission(role, req.GetPermission()),
+		Role:    toProtoRole(role),
+	}, nil
+}
+
+func (s *Service) GrantRole(ctx context.Context, req *userv1.GrantRoleRequest) (*userv1.GrantRoleResponse, error) {
+	role, ok := fromProtoRole(req.GetRole())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+
+	previous, err := s.changeRole(ctx, req.GetActorUserId(), req.GetTargetUserId(), role)
+	if err != nil {
+		return nil, err
+	}
+	return &userv1.GrantRoleResponse{PreviousRole: toProtoRole(previous)}, nil
+}
+
+func (s *Service) RevokeRole(ctx context.Context, req *userv1.RevokeRoleRequest) (*userv1.RevokeRoleResponse, error) {
+	previous, err := s.changeRole(ctx, req.GetActorUserId(), req.GetTargetUserId(), RoleUser)
+	if err != nil {
+		return nil, err
+	}
+	return &userv1.RevokeRoleResponse{PreviousRole: toProtoRole(previous)}, nil
+}
+
+// changeRole проверяет, что actor — администратор, и меняет роль target.
+// Свою роль менять нельзя, чтобы последний администратор не лишил себя прав.
+func (s *Service) changeRole(ctx context.Context, actorID, targetID int64, role string) (string, error) {
+	if actorID == 0 || targetID == 0 {
+		return "", status.Error(codes.InvalidArgument, "actor_user_id and target_user_id are required")
+	}
+	if actorID == targetID {
+		return "", status.Error(codes.FailedPrecondition, "cannot change own role")
+	}
+
+	if err := s.requirePermission(ctx, actorID, userv1.Permission_PERMISSION_MANAGE_ROLES); err != nil {
+		return "", err
+	}
+
+	previous, err := s.repo.SetRole(ctx, targetID, role, actorID)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return "", status.Error(codes.NotFound, "user not found")
+		}
+		s.logger.Error("failed to set role", zap.Error(err))
+		return "", status.Error(codes.Internal, "failed to change role")
+	}
+	return previous, nil
+}
+
+// BanUser банит пользователя. Банить могут модераторы и администраторы, но не друг друга.
+func (s *Service) BanUser(ctx context.Context, req *userv1.BanUserRequest) (*userv1.BanUserResponse, error) {
+	if req.GetActorUserId() == 0 || req.GetTargetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "actor_user_id and target_user_id are required")
+	}
+	scope, ok := fromProtoBanScope(req.GetScope())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "scope is required")
+	}
+	if req.GetDurationSeconds() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "duration_seconds must not be negative")
+	}
+	reason := strings.TrimSpace(req.GetReason())
+	if utf8.RuneCountInString(reason) > maxBanReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must not exceed %d characters", maxBanReasonLength)
+	}
+	if req.GetActorUserId() == req.GetTargetUserId() {
+		return nil, status.Error(codes.FailedPrecondition, "cannot ban yourself")
+	}
+
+	if err := s.requirePermission(ctx, req.GetActorUserId(), userv1.Permission_PERMISSION_BAN_USERS); err != nil {
+		return nil, err
+	}
+	targetRole, err := s.repo.GetRole(ctx, req.GetTargetUserId())
+	if err != nil {
+		if err == ErrUserNotFound {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		s.logger.Error("failed to get role", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to ban user")
+	}
+	if hasPermission(targetRole, userv1.Permission_PERMISSION_BAN_USERS) {
+		return nil, status.Error(codes.FailedPrecondition, "moderators and admins cannot be banned")
+	}
+
+	ban := Ban{
+		UserID:    req.GetTargetUserId(),
+		Scope:     scope,
+		Reason:    reason,
+		Shadow:    req.GetShadow(),
+		CreatedBy: req.GetActorUserId(),
+	}
+	resp := &userv1.BanUserResponse{}
+	if req.GetDurationSeconds() > 0 {
+		expiresAt := time.Now().Add(time.Duration(req.GetDurationSeconds()) * time.Second).UTC()
+		ban.ExpiresAt = &expiresAt
+		resp.ExpiresAt = expiresAt.Format(time.RFC3339)
+	}
+
+	resp.BanId, err = s.repo.CreateBan(ctx, ban)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		s.logger.Error("failed to create ban", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to ban user")
+	}
+	return resp, nil
+}
+
+func (s *Service) UnbanUser(ctx context.Context, req *userv1.UnbanUserRequest) (*userv1.UnbanUserResponse, error) {
+	if req.GetActorUserId() == 0 || req.GetBanId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "actor_user_id and ban_id are required")
+	}
+	if err := s.requirePermission(ctx, req.GetActorUserId(), userv1.Permission_PERMISSION_BAN_USERS); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.RevokeBan(ctx, req.GetBanId()); err != nil {
+		if err == ErrBanNotFound {
+			return nil, status.Error(codes.NotFound, "active ban not found")
+		}
+		s.logger.Error("failed to revoke ban", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to unban user")
+	}
+	return &userv1.UnbanUserResponse{}, nil
+}
+
+// CheckBan сообщает, запрещено ли пользователю действие из scope и не скрыт ли его контент.
+func (s *Service) CheckBan(ctx context.Context, req *userv1.CheckBanRequest) (*userv1.CheckBanResponse, error) {
+	if req.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	scope, ok := fromProtoBanScope(req.GetScope())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "scope is required")
+	}
+
+	ban, found, err := s.repo.ActiveBan(ctx, req.GetUserId(), scope)
+	if err != nil {
+		s.logger.Error("failed to check ban", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to check ban")
+	}
+	if !found {
+		return &userv1.CheckBanResponse{}, nil
+	}
+
+	resp := &userv1.CheckBanResponse{
+		Banned: !ban.Shadow,
+		Shadow: ban.Shadow,
+	}
+	// теневой бан не должен выдавать себя ни причиной, ни сроком
+	if !ban.Shadow {
+		resp.Reason = ban.Reason
+		if ban.ExpiresAt != nil {
+			resp.ExpiresAt = ban.ExpiresAt.UTC().Format(time.RFC3339)
+		}
+	}
+	return resp, nil
+}
+
+func (s *Service) requirePermission(ctx context.Context, userID int64, perm userv1.Permission) error {
+	role, err := s.repo.GetRole(ctx, userID)
+	if err != nil && err != ErrUserNotFound {
+		s.logger.Error("failed to get role", zap.Error(err))
+		return status.Error(codes.Internal, "failed to check permission")
+	}
+	if !hasPermission(role, perm) {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}